@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
@@ -17,12 +18,49 @@ type Etcd struct {
 }
 
 type ApiBaseUrls struct {
-	Grps string `yaml:"grpc"` // host:port для доступа к gRPC API (пустая строка, если нет)
-	Rest string `yaml:"rest"` // host:port для доступа к REST API (пустая строка, если нет)
+	Grps    string `yaml:"grpc"`    // host:port для доступа к gRPC API (пустая строка, если нет)
+	Rest    string `yaml:"rest"`    // host:port для доступа к REST API (пустая строка, если нет)
+	Metrics string `yaml:"metrics"` // host:port для сбора метрик Prometheus (GET /metrics, пустая строка - не публикуются)
 }
 
 type GRPCConfig struct {
-	SharesProcessor string `yaml:"shares_processor"` // ServiceDiscovery ID для адреса сервиса процессинга шар
+	SharesProcessor string `yaml:"shares_processor"` // ServiceDiscovery ID для адреса сервиса процессинга шар (пустой - SubmitShares отключен)
+}
+
+// CacheConfig настройки кэша ID монет, кошельков и воркеров (размер 0 - кэш отключен)
+type CacheConfig struct {
+	CoinSize    int           `yaml:"coin_size"`    // максимальное количество монет в кэше
+	WalletSize  int           `yaml:"wallet_size"`  // максимальное количество кошельков в кэше
+	WorkerSize  int           `yaml:"worker_size"`  // максимальное количество воркеров в кэше
+	NegativeTTL time.Duration `yaml:"negative_ttl"` // время жизни отрицательного результата ("записи нет"), например 30s
+
+	RewardMethodTTL time.Duration `yaml:"reward_method_ttl"` // время жизни списка методов начисления вознаграждения монеты
+}
+
+// OfflineDetectorConfig настройки фонового отключения молчащих воркеров (interval 0 - отключено)
+type OfflineDetectorConfig struct {
+	Interval      time.Duration            `yaml:"interval"`       // период проверки, например 1m
+	SilenceWindow time.Duration            `yaml:"silence_window"` // воркер отключается, если от него не было шар дольше окна
+	CoinWindows   map[string]time.Duration `yaml:"coin_windows"`   // окна отдельных монет (символ => окно, 0 - не проверять монету)
+}
+
+// DedupConfig настройки поиска повторно присланных шар (window 0 - повторы ищутся только внутри запроса SubmitShares)
+type DedupConfig struct {
+	Window          time.Duration `yaml:"window"`           // окно, в пределах которого шара считается повтором, например 10m
+	CleanupInterval time.Duration `yaml:"cleanup_interval"` // период удаления устаревших ключей из БД (0 - раз в окно)
+}
+
+// RewardConfig условия расчета стоимости шар (десятичные числа строками, пустая строка - 0)
+type RewardConfig struct {
+	PoolFee string                      `yaml:"pool_fee"` // комиссия пула, доля (0.015 - 1,5%)
+	Coins   map[string]CoinRewardConfig `yaml:"coins"`    // условия по символу монеты
+}
+
+// CoinRewardConfig условия расчета стоимости шар монеты, которых нет в справочнике монет
+type CoinRewardConfig struct {
+	NetworkDifficulty string `yaml:"network_difficulty"` // сложность сети в единицах сложности шар (пустая - средняя сложность раунда монеты)
+	BlockFees         string `yaml:"block_fees"`         // средняя сумма комиссий транзакций в блоке (учитывается FPPS)
+	HashesPerDiff     string `yaml:"hashes_per_diff"`    // хешей на единицу сложности шары (нужно для ставки PPS)
 }
 
 type Config struct {
@@ -35,10 +73,18 @@ type Config struct {
 	ServiceDiscoveryList map[string]string // список текущих сервисов из Service Discovery
 	PostgresDSN          string            `yaml:"postgres_dsn" envconfig:"POSTGRES_DSN" required:"false"`
 	//GrpcPort             string            `yaml:"grpc_port" envconfig:"GRPC_PORT" required:"false"`
-	JWTServiceName   string     `yaml:"jwt_service_name" envconfig:"JWT_SERVICE_NAME" required:"false"`     // Название сервиса (для сверки с JWTValidServices при авторизаии)
-	JWTSecret        string     `yaml:"jwt_secret" envconfig:"JWT_SECRET" required:"false"`                 // JWT секрет
-	JWTValidServices []string   `yaml:"jwt_valid_services" envconfig:"JWT_VALID_SERVICES" required:"false"` // список микросервисов (через запятую), которым разрешен доступ
-	GRPCConfig       GRPCConfig `yaml:"grpc"`
+	JWTServiceName   string      `yaml:"jwt_service_name" envconfig:"JWT_SERVICE_NAME" required:"false"`     // Название сервиса (для сверки с JWTValidServices при авторизаии)
+	JWTSecret        string      `yaml:"jwt_secret" envconfig:"JWT_SECRET" required:"false"`                 // JWT секрет
+	JWTValidServices []string    `yaml:"jwt_valid_services" envconfig:"JWT_VALID_SERVICES" required:"false"` // список микросервисов (через запятую), которым разрешен доступ
+	JWTAdminServices []string    `yaml:"jwt_admin_services" envconfig:"JWT_ADMIN_SERVICES" required:"false"` // микросервисы (из JWTValidServices), которым разрешены административные методы
+	JWTRateServices  []string    `yaml:"jwt_rate_services" envconfig:"JWT_RATE_SERVICES" required:"false"`   // микросервисы (из JWTValidServices), которым разрешено изменять курсы и вознаграждение монет
+	JWTRelayServices []string    `yaml:"jwt_relay_services" envconfig:"JWT_RELAY_SERVICES" required:"false"` // микросервисы (из JWTValidServices), которые получают токены подтверждения и доставляют их майнеру
+	GRPCConfig       GRPCConfig  `yaml:"grpc"`
+	Cache            CacheConfig `yaml:"cache"`
+
+	OfflineDetector OfflineDetectorConfig `yaml:"offline_detector"`
+	Dedup           DedupConfig           `yaml:"dedup"`
+	Reward          RewardConfig          `yaml:"reward"`
 }
 
 func New(filePath string, envFile string) (Config, error) {
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+// AdminMethods методы, доступные только административным сервисам (см. jwt GetAdminInterceptor)
+var AdminMethods = []string{
+	proto.MinersService_CreateCoin_FullMethodName,
+	proto.MinersService_UpdateCoin_FullMethodName,
+	proto.MinersService_SetCoinActive_FullMethodName,
+	proto.MinersService_DeleteWallet_FullMethodName,
+	proto.MinersService_DeleteWorker_FullMethodName,
+	proto.MinersService_MergeWorkers_FullMethodName,
+	proto.MinersService_SetPaymentThreshold_FullMethodName, // майнер меняет порог только через RequestSettingsChange
+}
+
+// RelayMethods методы, возвращающие токен подтверждения: доступны только сервисам, доставляющим токен майнеру
+// по подтвержденному каналу (см. jwt_relay_services), токен не должен возвращаться инициатору изменения
+var RelayMethods = []string{
+	proto.MinersService_RequestSettingsChange_FullMethodName,
+}
+
+// RateMethods методы изменения курсов и вознаграждения, доступные административным сервисам
+// и сервисам обновления курсов (см. jwt_rate_services)
+var RateMethods = []string{
+	proto.MinersService_PatchCoinParams_FullMethodName,
+}
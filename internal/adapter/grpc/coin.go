@@ -0,0 +1,258 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// ListCoins монеты справочника
+func (s *GRPCServer) ListCoins(ctx context.Context, req *proto.ListCoinsRequest) (*proto.ListCoinsResponse, error) {
+	coins, err := s.coins.ListCoins(ctx, req.ActiveOnly)
+	if err != nil {
+		return nil, statusError("ListCoins", err)
+	}
+
+	resp := &proto.ListCoinsResponse{
+		Coins: make([]*proto.Coin, len(coins)),
+	}
+	for i, c := range coins {
+		resp.Coins[i] = coinToProto(c)
+	}
+
+	return resp, nil
+}
+
+// GetCoin монета по ID (неизвестная монета - codes.NotFound)
+func (s *GRPCServer) GetCoin(ctx context.Context, req *proto.GetCoinRequest) (*proto.GetCoinResponse, error) {
+	coin, err := s.coins.GetCoin(ctx, req.Id)
+	if err != nil {
+		return nil, statusError("GetCoin", err)
+	}
+
+	return &proto.GetCoinResponse{Coin: coinToProto(coin)}, nil
+}
+
+// CreateCoin добавление монеты (символ занят - codes.AlreadyExists)
+func (s *GRPCServer) CreateCoin(ctx context.Context, req *proto.CreateCoinRequest) (*proto.CreateCoinResponse, error) {
+	coin, err := coinFromProto(req.Coin)
+	if err != nil {
+		return nil, invalidArgument("CreateCoin", err.Error())
+	}
+	if coin.Params, err = coinParamsFromProto(req.Coin.Params); err != nil {
+		return nil, invalidArgument("CreateCoin", "coin.params."+err.Error())
+	}
+	if err := coin.Params.Validate(); err != nil {
+		return nil, invalidArgument("CreateCoin", "coin.params: "+err.Error())
+	}
+
+	newID, err := s.coins.CreateCoin(ctx, coin)
+	if err != nil {
+		return nil, statusError("CreateCoin", err)
+	}
+
+	return &proto.CreateCoinResponse{Id: newID}, nil
+}
+
+// UpdateCoin изменение описания монеты, возвращает монету после изменения
+func (s *GRPCServer) UpdateCoin(ctx context.Context, req *proto.UpdateCoinRequest) (*proto.UpdateCoinResponse, error) {
+	if req.Coin.Id <= 0 {
+		return nil, invalidArgument("UpdateCoin", "coin.id: value must be greater than 0")
+	}
+
+	coin, err := coinFromProto(req.Coin)
+	if err != nil {
+		return nil, invalidArgument("UpdateCoin", err.Error())
+	}
+	if err := s.coins.UpdateCoin(ctx, coin); err != nil {
+		return nil, statusError("UpdateCoin", err)
+	}
+
+	coin, err = s.coins.GetCoin(ctx, req.Coin.Id)
+	if err != nil {
+		return nil, statusError("UpdateCoin", err)
+	}
+
+	return &proto.UpdateCoinResponse{Coin: coinToProto(coin)}, nil
+}
+
+// SetCoinActive включение/выключение монеты
+func (s *GRPCServer) SetCoinActive(ctx context.Context, req *proto.SetCoinActiveRequest) (*proto.SetCoinActiveResponse, error) {
+	if err := s.coins.SetCoinActive(ctx, req.Id, req.IsActive); err != nil {
+		return nil, statusError("SetCoinActive", err)
+	}
+
+	return &proto.SetCoinActiveResponse{}, nil
+}
+
+func coinToProto(c entity.Coin) *proto.Coin {
+	return &proto.Coin{
+		Id:                    c.ID,
+		Symbol:                c.Symbol,
+		Symbol2:               c.Symbol2,
+		Name:                  c.Name,
+		Algo:                  c.Algo,
+		Image:                 c.Image,
+		MinWithdraw:           decimalToProto(c.MinWithdraw),
+		TransactionsExplorer:  c.TransactionsExplorer,
+		BlockExplorer:         c.BlockExplorer,
+		IsActive:              c.IsActive,
+		Params:                coinParamsToProto(c.Params),
+		AverageRoundDiff:      decimalToProto(c.AverageRoundDiff),
+		AverageSoloRoundDiff:  decimalToProto(c.AverageSoloRoundDiff),
+		CurrentEffort:         decimalToProto(c.CurrentEffort),
+		CoinsInBlock:          decimalToProto(c.CoinsInBlock),
+		AveragePpsRoundDiff:   decimalToProto(c.AveragePPSRoundDiff),
+		AverageEffort:         decimalToProto(c.AverageEffort),
+		AverageLastEffort:     decimalToProto(c.AverageLastEffort),
+		SeoTitle:              c.SeoTitle,
+		LastRewardProcessedId: c.LastRewardProcessedID,
+		MaxPaymentThreshold:   optionalDecimalToProto(c.MaxPaymentThreshold),
+	}
+}
+
+// coinFromProto только поля, которые можно задать через CreateCoin/UpdateCoin (параметры - отдельно),
+// ошибка - описание некорректного десятичного поля
+func coinFromProto(c *proto.Coin) (entity.Coin, error) {
+	coin := entity.Coin{
+		ID:                   c.Id,
+		Symbol:               c.Symbol,
+		Symbol2:              c.Symbol2,
+		Name:                 c.Name,
+		Algo:                 c.Algo,
+		Image:                c.Image,
+		TransactionsExplorer: c.TransactionsExplorer,
+		BlockExplorer:        c.BlockExplorer,
+		IsActive:             c.IsActive,
+		SeoTitle:             c.SeoTitle,
+	}
+
+	var err error
+	if coin.MinWithdraw, err = decimalFromOptionalProto(c.MinWithdraw); err != nil {
+		return coin, fmt.Errorf("coin.min_withdraw: %w", err)
+	}
+	if coin.CoinsInBlock, err = decimalFromOptionalProto(c.CoinsInBlock); err != nil {
+		return coin, fmt.Errorf("coin.coins_in_block: %w", err)
+	}
+	if coin.MaxPaymentThreshold, err = optionalDecimalFromProto(c.MaxPaymentThreshold); err != nil {
+		return coin, fmt.Errorf("coin.max_payment_threshold: %w", err)
+	}
+
+	return coin, nil
+}
+
+// GetCoinParams дополнительные параметры монеты
+func (s *GRPCServer) GetCoinParams(ctx context.Context, req *proto.GetCoinParamsRequest) (*proto.GetCoinParamsResponse, error) {
+	params, err := s.coins.GetCoinParams(ctx, req.CoinId)
+	if err != nil {
+		return nil, statusError("GetCoinParams", err)
+	}
+
+	return &proto.GetCoinParamsResponse{Params: coinParamsToProto(params)}, nil
+}
+
+// PatchCoinParams изменение только переданных параметров монеты
+func (s *GRPCServer) PatchCoinParams(ctx context.Context, req *proto.PatchCoinParamsRequest) (*proto.PatchCoinParamsResponse, error) {
+	var patch entity.CoinParamsPatch
+	var err error
+	if patch.CurrencyRates, err = currencyRatesFromProto(req.CurrencyRates); err != nil {
+		return nil, invalidArgument("PatchCoinParams", err.Error())
+	}
+	for name, f := range map[string]struct {
+		src *proto.Decimal
+		dst **entity.Decimal
+	}{
+		"current_reward_per_gigahash":           {req.CurrentRewardPerGigahash, &patch.CurrentRewardPerGigahash},
+		"current_reward_per_gigahash_solo":      {req.CurrentRewardPerGigahashSolo, &patch.CurrentRewardPerGigahashSolo},
+		"current_reward_per_gigahash_pps":       {req.CurrentRewardPerGigahashPps, &patch.CurrentRewardPerGigahashPPS},
+		"current_reward_per_gigahash_minerstat": {req.CurrentRewardPerGigahashMinerstat, &patch.CurrentRewardPerGigahashMinerstat},
+	} {
+		if f.src == nil {
+			continue
+		}
+		v, err := decimalFromProto(f.src)
+		if err != nil {
+			return nil, invalidArgument("PatchCoinParams", name+": "+err.Error())
+		}
+		*f.dst = &v
+	}
+	if patch.Empty() {
+		return nil, invalidArgument("PatchCoinParams", "no params to change")
+	}
+	if err := patch.Validate(); err != nil {
+		return nil, invalidArgument("PatchCoinParams", err.Error())
+	}
+
+	params, err := s.coins.PatchCoinParams(ctx, req.CoinId, patch)
+	if err != nil {
+		return nil, statusError("PatchCoinParams", err)
+	}
+
+	return &proto.PatchCoinParamsResponse{Params: coinParamsToProto(params)}, nil
+}
+
+func coinParamsToProto(p entity.CoinParams) *proto.CoinParams {
+	rates := make(map[string]*proto.Decimal, len(p.CurrencyRates))
+	for code, rate := range p.CurrencyRates {
+		rates[code] = decimalToProto(rate)
+	}
+
+	return &proto.CoinParams{
+		CurrencyRates:                     rates,
+		CurrentRewardPerGigahash:          decimalToProto(p.CurrentRewardPerGigahash),
+		CurrentRewardPerGigahashSolo:      decimalToProto(p.CurrentRewardPerGigahashSolo),
+		CurrentRewardPerGigahashPps:       decimalToProto(p.CurrentRewardPerGigahashPPS),
+		CurrentRewardPerGigahashMinerstat: decimalToProto(p.CurrentRewardPerGigahashMinerstat),
+	}
+}
+
+// coinParamsFromProto параметры (или отдельные значения) не заданы - значения по умолчанию
+func coinParamsFromProto(p *proto.CoinParams) (entity.CoinParams, error) {
+	if p == nil {
+		return entity.CoinParams{}, nil
+	}
+
+	var params entity.CoinParams
+	var err error
+	if params.CurrencyRates, err = currencyRatesFromProto(p.CurrencyRates); err != nil {
+		return entity.CoinParams{}, err
+	}
+	for name, f := range map[string]struct {
+		src *proto.Decimal
+		dst *entity.Decimal
+	}{
+		"current_reward_per_gigahash":           {p.CurrentRewardPerGigahash, &params.CurrentRewardPerGigahash},
+		"current_reward_per_gigahash_solo":      {p.CurrentRewardPerGigahashSolo, &params.CurrentRewardPerGigahashSolo},
+		"current_reward_per_gigahash_pps":       {p.CurrentRewardPerGigahashPps, &params.CurrentRewardPerGigahashPPS},
+		"current_reward_per_gigahash_minerstat": {p.CurrentRewardPerGigahashMinerstat, &params.CurrentRewardPerGigahashMinerstat},
+	} {
+		if f.src == nil {
+			continue
+		}
+		if *f.dst, err = decimalFromProto(f.src); err != nil {
+			return entity.CoinParams{}, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return params, nil
+}
+
+// currencyRatesFromProto курсы валют (nil - курсы не заданы)
+func currencyRatesFromProto(rates map[string]*proto.Decimal) (map[string]entity.Decimal, error) {
+	if len(rates) == 0 {
+		return nil, nil
+	}
+
+	res := make(map[string]entity.Decimal, len(rates))
+	for code, rate := range rates {
+		v, err := decimalFromProto(rate)
+		if err != nil {
+			return nil, fmt.Errorf("currency_rates.%s: %w", code, err)
+		}
+		res[code] = v
+	}
+
+	return res, nil
+}
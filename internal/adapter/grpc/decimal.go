@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+func decimalToProto(d entity.Decimal) *proto.Decimal {
+	return &proto.Decimal{Value: d.String()}
+}
+
+// optionalDecimalToProto не заданное значение - nil
+func optionalDecimalToProto(d *entity.Decimal) *proto.Decimal {
+	if d == nil {
+		return nil
+	}
+	return decimalToProto(*d)
+}
+
+// decimalFromProto значение проверено валидатором (required, decimal), здесь - повторная проверка для вызовов без интерсептора
+func decimalFromProto(d *proto.Decimal) (entity.Decimal, error) {
+	return entity.ParseDecimal(d.GetValue())
+}
+
+// decimalFromOptionalProto необязательные десятичные поля (Coin и т.п.): не задано - 0
+func decimalFromOptionalProto(d *proto.Decimal) (entity.Decimal, error) {
+	if d == nil {
+		return entity.Decimal{}, nil
+	}
+	return decimalFromProto(d)
+}
+
+// optionalDecimalFromProto не задано - значение не задано (nil)
+func optionalDecimalFromProto(d *proto.Decimal) (*entity.Decimal, error) {
+	if d == nil {
+		return nil, nil
+	}
+	v, err := decimalFromProto(d)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
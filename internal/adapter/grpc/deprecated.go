@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/dnsoftware/mpm-save-get-shares/pkg/logger"
+	"google.golang.org/grpc/peer"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+	"github.com/dnsoftware/mpm-miners-processor/internal/metrics"
+	jwtauth "github.com/dnsoftware/mpm-miners-processor/pkg/jwt"
+)
+
+// deprecatedUsage учет клиентов, которые еще передают устаревшие поля запросов.
+// Счетчики публикуются метрикой metrics.DeprecatedFieldRequests: когда она перестанет расти - поле можно удалять (см. migration/deferred)
+type deprecatedUsage struct {
+	isSolo  atomic.Int64
+	callers sync.Map // "метод клиент" => struct{}, предупреждение в лог пишется один раз
+}
+
+// noteIsSolo учет передачи устаревшего is_solo.
+// В proto3 false неотличим от отсутствия поля, поэтому учитываются только запросы с is_solo = true
+func (s *GRPCServer) noteIsSolo(ctx context.Context, method string, isSolo bool, rewardMethod entity.RewardMethod) {
+	if !isSolo {
+		return
+	}
+	s.deprecated.isSolo.Add(1)
+
+	caller := callerName(ctx)
+	metrics.DeprecatedFieldRequests.WithLabelValues(method, "is_solo", caller).Inc()
+	if _, loaded := s.deprecated.callers.LoadOrStore(method+" "+caller, struct{}{}); loaded {
+		return
+	}
+	logger.Log().Warn(fmt.Sprintf("%s: client %s (%s) sends deprecated is_solo (reward_method %q, is_solo is ignored)", method, caller, peerAddr(ctx), rewardMethod))
+}
+
+// DeprecatedIsSoloCount количество запросов с устаревшим is_solo с момента запуска
+func (s *GRPCServer) DeprecatedIsSoloCount() int64 {
+	return s.deprecated.isSolo.Load()
+}
+
+// callerName имя сервиса из JWT (если есть), иначе "anonymous" (значение метки метрики:
+// адрес клиента в метку не попадает, иначе число рядов метрики не ограничено - он пишется только в лог)
+func callerName(ctx context.Context) string {
+	if claims, ok := ctx.Value("claims").(*jwtauth.ClaimsSymmetric); ok && claims.ServiceName != "" {
+		return claims.ServiceName
+	}
+	return "anonymous"
+}
+
+// peerAddr адрес клиента без порта (для лога)
+func peerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return "unknown"
+}
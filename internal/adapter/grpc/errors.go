@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+)
+
+// errorModel преобразование ошибки хранилища в gRPC статус (своя модель у каждой версии сервиса)
+type errorModel func(method string, err error) error
+
+// internalError модель ошибок версии 1: любая ошибка хранилища - codes.Internal с деталями MPError
+// (старые клиенты не различают коды, менять их нельзя)
+func internalError(method string, err error) error {
+	return statusWithDetail(codes.Internal, method, err.Error())
+}
+
+// statusError ошибка хранилища в виде gRPC статуса с деталями MPError
+// storage.ErrNotFound => codes.NotFound, storage.ErrAlreadyExists => codes.AlreadyExists, остальные ошибки => codes.Internal
+func statusError(method string, err error) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, storage.ErrAlreadyExists):
+		code = codes.AlreadyExists
+	}
+
+	return statusWithDetail(code, method, err.Error())
+}
+
+// invalidArgument ошибка некорректного запроса (codes.InvalidArgument) с деталями MPError
+func invalidArgument(method string, description string) error {
+	return statusWithDetail(codes.InvalidArgument, method, description)
+}
+
+func statusWithDetail(code codes.Code, method string, description string) error {
+	st := status.New(code, description)
+	detail := &proto.MPError{
+		Method:      method,
+		Description: description,
+	}
+	if withDetail, err := st.WithDetails(detail); err == nil {
+		st = withDetail
+	}
+
+	return st.Err()
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// pageToken курсор keyset пагинации - последняя запись предыдущей страницы
+// передается клиенту в непрозрачном виде (base64 от JSON)
+type pageToken struct {
+	Sort int32  `json:"s"`           // поле сортировки (значение enum из запроса)
+	Desc bool   `json:"d,omitempty"` // сортировка по убыванию
+	ID   int64  `json:"id"`          // ID последней записи
+	Str  string `json:"v,omitempty"` // значение строкового поля сортировки
+	Int  int64  `json:"n,omitempty"` // значение числового поля сортировки (время - unix time в миллисекундах)
+}
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+func (t pageToken) encode() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken разбор курсора, sort и desc должны совпадать с запросом
+func decodePageToken(s string, sort int32, desc bool) (pageToken, error) {
+	var t pageToken
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, errInvalidPageToken
+	}
+	if err := json.Unmarshal(data, &t); err != nil || t.ID <= 0 {
+		return t, errInvalidPageToken
+	}
+	if t.Sort != sort || t.Desc != desc {
+		return t, errors.New("page token does not match sort order of the request")
+	}
+
+	return t, nil
+}
+
+// pageSize размер страницы из запроса (0 или меньше - по умолчанию, больше допустимого - максимально допустимый)
+func pageSize(size int32, defaultSize int, maxSize int) int {
+	switch {
+	case size <= 0:
+		return defaultSize
+	case int(size) > maxSize:
+		return maxSize
+	}
+	return int(size)
+}
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// GetPaymentThreshold порог выплаты кошелька и ограничения монеты
+func (s *GRPCServer) GetPaymentThreshold(ctx context.Context, req *proto.GetPaymentThresholdRequest) (*proto.GetPaymentThresholdResponse, error) {
+	wallet, coin, err := s.walletWithCoin(ctx, req.WalletId)
+	if err != nil {
+		return nil, statusError("GetPaymentThreshold", err)
+	}
+
+	return &proto.GetPaymentThresholdResponse{
+		PaymentThreshold:          decimalToProto(wallet.PaymentThreshold),
+		EffectivePaymentThreshold: decimalToProto(entity.EffectivePaymentThreshold(wallet.PaymentThreshold, coin)),
+		MinWithdraw:               decimalToProto(coin.MinWithdraw),
+		MaxPaymentThreshold:       optionalDecimalToProto(coin.MaxPaymentThreshold),
+	}, nil
+}
+
+// SetPaymentThreshold изменение порога выплаты кошелька без подтверждения (только для административных сервисов,
+// см. AdminMethods; вне диапазона монеты - codes.InvalidArgument)
+// в журнал записываются сервис из JWT и переданный им пользователь
+func (s *GRPCServer) SetPaymentThreshold(ctx context.Context, req *proto.SetPaymentThresholdRequest) (*proto.SetPaymentThresholdResponse, error) {
+	_, coin, err := s.walletWithCoin(ctx, req.WalletId)
+	if err != nil {
+		return nil, statusError("SetPaymentThreshold", err)
+	}
+	threshold, err := paymentThresholdFromProto(req.PaymentThreshold, coin)
+	if err != nil {
+		return nil, invalidArgument("SetPaymentThreshold", "payment_threshold: "+err.Error())
+	}
+
+	change, err := s.wallets.SetPaymentThreshold(ctx, entity.PaymentThresholdChange{
+		WalletID: req.WalletId,
+		NewValue: threshold,
+		Service:  callerName(ctx),
+		Actor:    req.Actor,
+	})
+	if err != nil {
+		return nil, statusError("SetPaymentThreshold", err)
+	}
+
+	return &proto.SetPaymentThresholdResponse{
+		PaymentThreshold:          decimalToProto(change.NewValue),
+		EffectivePaymentThreshold: decimalToProto(entity.EffectivePaymentThreshold(change.NewValue, coin)),
+		Changed:                   change.ID > 0,
+	}, nil
+}
+
+// ListPaymentThresholdChanges журнал изменений порога выплаты кошелька (последние сначала)
+func (s *GRPCServer) ListPaymentThresholdChanges(ctx context.Context, req *proto.ListPaymentThresholdChangesRequest) (*proto.ListPaymentThresholdChangesResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 || limit > constants.PaymentThresholdHistoryMaxLimit {
+		limit = constants.PaymentThresholdHistoryMaxLimit
+	}
+
+	if _, err := s.wallets.GetWallet(ctx, req.WalletId); err != nil {
+		return nil, statusError("ListPaymentThresholdChanges", err)
+	}
+
+	changes, err := s.wallets.ListPaymentThresholdChanges(ctx, req.WalletId, limit)
+	if err != nil {
+		return nil, statusError("ListPaymentThresholdChanges", err)
+	}
+
+	resp := &proto.ListPaymentThresholdChangesResponse{
+		Changes: make([]*proto.PaymentThresholdChange, len(changes)),
+	}
+	for i, c := range changes {
+		resp.Changes[i] = &proto.PaymentThresholdChange{
+			OldValue:  decimalToProto(c.OldValue),
+			NewValue:  decimalToProto(c.NewValue),
+			Service:   c.Service,
+			Actor:     c.Actor,
+			ChangedAt: c.ChangedAt.UnixMilli(),
+		}
+	}
+
+	return resp, nil
+}
+
+// paymentThresholdFromProto разбор и проверка порога выплаты для монеты coin
+func paymentThresholdFromProto(value *proto.Decimal, coin entity.Coin) (entity.Decimal, error) {
+	threshold, err := decimalFromProto(value)
+	if err != nil {
+		return entity.Decimal{}, err
+	}
+	if err := entity.ValidatePaymentThreshold(threshold, coin); err != nil {
+		return entity.Decimal{}, err
+	}
+
+	return threshold, nil
+}
+
+// walletWithCoin кошелек и его монета
+func (s *GRPCServer) walletWithCoin(ctx context.Context, walletID int64) (entity.Wallet, entity.Coin, error) {
+	wallet, err := s.wallets.GetWallet(ctx, walletID)
+	if err != nil {
+		return entity.Wallet{}, entity.Coin{}, err
+	}
+	coin, err := s.coins.GetCoin(ctx, wallet.CoinID)
+	if err != nil {
+		return entity.Wallet{}, entity.Coin{}, err
+	}
+
+	return wallet, coin, nil
+}
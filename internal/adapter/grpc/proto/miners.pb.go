@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,12 +21,237 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Методы начисления вознаграждения
+// (в запросах метод передается строковым кодом: "PPLNS", "PPS", "PPS+", "FPPS", "SOLO", "PROP")
+type RewardMethod int32
+
+const (
+	RewardMethod_REWARD_METHOD_UNSPECIFIED RewardMethod = 0
+	RewardMethod_REWARD_METHOD_PPLNS       RewardMethod = 1
+	RewardMethod_REWARD_METHOD_PPS         RewardMethod = 2
+	RewardMethod_REWARD_METHOD_PPS_PLUS    RewardMethod = 3
+	RewardMethod_REWARD_METHOD_FPPS        RewardMethod = 4
+	RewardMethod_REWARD_METHOD_SOLO        RewardMethod = 5
+	RewardMethod_REWARD_METHOD_PROP        RewardMethod = 6
+)
+
+// Enum value maps for RewardMethod.
+var (
+	RewardMethod_name = map[int32]string{
+		0: "REWARD_METHOD_UNSPECIFIED",
+		1: "REWARD_METHOD_PPLNS",
+		2: "REWARD_METHOD_PPS",
+		3: "REWARD_METHOD_PPS_PLUS",
+		4: "REWARD_METHOD_FPPS",
+		5: "REWARD_METHOD_SOLO",
+		6: "REWARD_METHOD_PROP",
+	}
+	RewardMethod_value = map[string]int32{
+		"REWARD_METHOD_UNSPECIFIED": 0,
+		"REWARD_METHOD_PPLNS":       1,
+		"REWARD_METHOD_PPS":         2,
+		"REWARD_METHOD_PPS_PLUS":    3,
+		"REWARD_METHOD_FPPS":        4,
+		"REWARD_METHOD_SOLO":        5,
+		"REWARD_METHOD_PROP":        6,
+	}
+)
+
+func (x RewardMethod) Enum() *RewardMethod {
+	p := new(RewardMethod)
+	*p = x
+	return p
+}
+
+func (x RewardMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RewardMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_miners_proto_enumTypes[0].Descriptor()
+}
+
+func (RewardMethod) Type() protoreflect.EnumType {
+	return &file_proto_miners_proto_enumTypes[0]
+}
+
+func (x RewardMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RewardMethod.Descriptor instead.
+func (RewardMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{0}
+}
+
+// Поле сортировки списка кошельков (при равенстве значений порядок - по id)
+type WalletSortField int32
+
+const (
+	WalletSortField_WALLET_SORT_ID               WalletSortField = 0
+	WalletSortField_WALLET_SORT_NAME             WalletSortField = 1
+	WalletSortField_WALLET_SORT_CURRENT_HASHRATE WalletSortField = 2
+	WalletSortField_WALLET_SORT_AVERAGE_HASHRATE WalletSortField = 3
+	WalletSortField_WALLET_SORT_CREATED_AT       WalletSortField = 4
+)
+
+// Enum value maps for WalletSortField.
+var (
+	WalletSortField_name = map[int32]string{
+		0: "WALLET_SORT_ID",
+		1: "WALLET_SORT_NAME",
+		2: "WALLET_SORT_CURRENT_HASHRATE",
+		3: "WALLET_SORT_AVERAGE_HASHRATE",
+		4: "WALLET_SORT_CREATED_AT",
+	}
+	WalletSortField_value = map[string]int32{
+		"WALLET_SORT_ID":               0,
+		"WALLET_SORT_NAME":             1,
+		"WALLET_SORT_CURRENT_HASHRATE": 2,
+		"WALLET_SORT_AVERAGE_HASHRATE": 3,
+		"WALLET_SORT_CREATED_AT":       4,
+	}
+)
+
+func (x WalletSortField) Enum() *WalletSortField {
+	p := new(WalletSortField)
+	*p = x
+	return p
+}
+
+func (x WalletSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WalletSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_miners_proto_enumTypes[1].Descriptor()
+}
+
+func (WalletSortField) Type() protoreflect.EnumType {
+	return &file_proto_miners_proto_enumTypes[1]
+}
+
+func (x WalletSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WalletSortField.Descriptor instead.
+func (WalletSortField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{1}
+}
+
+// Отбор воркеров по состоянию подключения
+type WorkerStatusFilter int32
+
+const (
+	WorkerStatusFilter_WORKER_STATUS_ALL     WorkerStatusFilter = 0
+	WorkerStatusFilter_WORKER_STATUS_ONLINE  WorkerStatusFilter = 1
+	WorkerStatusFilter_WORKER_STATUS_OFFLINE WorkerStatusFilter = 2
+)
+
+// Enum value maps for WorkerStatusFilter.
+var (
+	WorkerStatusFilter_name = map[int32]string{
+		0: "WORKER_STATUS_ALL",
+		1: "WORKER_STATUS_ONLINE",
+		2: "WORKER_STATUS_OFFLINE",
+	}
+	WorkerStatusFilter_value = map[string]int32{
+		"WORKER_STATUS_ALL":     0,
+		"WORKER_STATUS_ONLINE":  1,
+		"WORKER_STATUS_OFFLINE": 2,
+	}
+)
+
+func (x WorkerStatusFilter) Enum() *WorkerStatusFilter {
+	p := new(WorkerStatusFilter)
+	*p = x
+	return p
+}
+
+func (x WorkerStatusFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkerStatusFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_miners_proto_enumTypes[2].Descriptor()
+}
+
+func (WorkerStatusFilter) Type() protoreflect.EnumType {
+	return &file_proto_miners_proto_enumTypes[2]
+}
+
+func (x WorkerStatusFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkerStatusFilter.Descriptor instead.
+func (WorkerStatusFilter) EnumDescriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{2}
+}
+
+type ShareStatus int32
+
+const (
+	ShareStatus_SHARE_STATUS_UNSPECIFIED               ShareStatus = 0 // не используется (значение по умолчанию)
+	ShareStatus_SHARE_STATUS_ACCEPTED                  ShareStatus = 1 // передана сервису процессинга шар
+	ShareStatus_SHARE_STATUS_DUPLICATE                 ShareStatus = 2 // повтор UUID или nonce воркера (в запросе или в пределах окна дедупликации), не передается
+	ShareStatus_SHARE_STATUS_UNKNOWN_COIN              ShareStatus = 3 // монета не найдена или выключена, не передается
+	ShareStatus_SHARE_STATUS_UNSUPPORTED_REWARD_METHOD ShareStatus = 4 // метод начисления недоступен для монеты, не передается
+)
+
+// Enum value maps for ShareStatus.
+var (
+	ShareStatus_name = map[int32]string{
+		0: "SHARE_STATUS_UNSPECIFIED",
+		1: "SHARE_STATUS_ACCEPTED",
+		2: "SHARE_STATUS_DUPLICATE",
+		3: "SHARE_STATUS_UNKNOWN_COIN",
+		4: "SHARE_STATUS_UNSUPPORTED_REWARD_METHOD",
+	}
+	ShareStatus_value = map[string]int32{
+		"SHARE_STATUS_UNSPECIFIED":               0,
+		"SHARE_STATUS_ACCEPTED":                  1,
+		"SHARE_STATUS_DUPLICATE":                 2,
+		"SHARE_STATUS_UNKNOWN_COIN":              3,
+		"SHARE_STATUS_UNSUPPORTED_REWARD_METHOD": 4,
+	}
+)
+
+func (x ShareStatus) Enum() *ShareStatus {
+	p := new(ShareStatus)
+	*p = x
+	return p
+}
+
+func (x ShareStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_miners_proto_enumTypes[3].Descriptor()
+}
+
+func (ShareStatus) Type() protoreflect.EnumType {
+	return &file_proto_miners_proto_enumTypes[3]
+}
+
+func (x ShareStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareStatus.Descriptor instead.
+func (ShareStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{3}
+}
+
 type GetCoinIDByNameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Coin string `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+	Coin            string `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`                                               // символ или альтернативный символ (без учета регистра)
+	IncludeInactive bool   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // искать и среди выключенных монет
 }
 
 func (x *GetCoinIDByNameRequest) Reset() {
@@ -67,6 +293,13 @@ func (x *GetCoinIDByNameRequest) GetCoin() string {
 	return ""
 }
 
+func (x *GetCoinIDByNameRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
 type GetCoinIDByNameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -119,10 +352,11 @@ type CreateWalletRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CoinId       int64  `protobuf:"varint,2,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
-	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	IsSolo       bool   `protobuf:"varint,4,opt,name=is_solo,json=isSolo,proto3" json:"is_solo,omitempty"`
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CoinId int64  `protobuf:"varint,2,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Deprecated: Marked as deprecated in proto/miners.proto.
+	IsSolo       bool   `protobuf:"varint,4,opt,name=is_solo,json=isSolo,proto3" json:"is_solo,omitempty"` // устарело, соло режим определяется по reward_method
 	RewardMethod string `protobuf:"bytes,5,opt,name=reward_method,json=rewardMethod,proto3" json:"reward_method,omitempty"`
 }
 
@@ -179,6 +413,7 @@ func (x *CreateWalletRequest) GetName() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/miners.proto.
 func (x *CreateWalletRequest) GetIsSolo() bool {
 	if x != nil {
 		return x.IsSolo
@@ -245,14 +480,15 @@ type CreateWorkerRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CoinId       int64  `protobuf:"varint,2,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
-	Workerfull   string `protobuf:"bytes,3,opt,name=workerfull,proto3" json:"workerfull,omitempty"`
-	Wallet       string `protobuf:"bytes,4,opt,name=wallet,proto3" json:"wallet,omitempty"`
-	Worker       string `protobuf:"bytes,5,opt,name=worker,proto3" json:"worker,omitempty"`
-	ServerId     string `protobuf:"bytes,6,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Ip           string `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip,omitempty"`
-	IsSolo       bool   `protobuf:"varint,8,opt,name=is_solo,json=isSolo,proto3" json:"is_solo,omitempty"`
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CoinId     int64  `protobuf:"varint,2,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	Workerfull string `protobuf:"bytes,3,opt,name=workerfull,proto3" json:"workerfull,omitempty"`
+	Wallet     string `protobuf:"bytes,4,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Worker     string `protobuf:"bytes,5,opt,name=worker,proto3" json:"worker,omitempty"`
+	ServerId   string `protobuf:"bytes,6,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Ip         string `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Deprecated: Marked as deprecated in proto/miners.proto.
+	IsSolo       bool   `protobuf:"varint,8,opt,name=is_solo,json=isSolo,proto3" json:"is_solo,omitempty"` // устарело, соло режим определяется по reward_method
 	RewardMethod string `protobuf:"bytes,9,opt,name=reward_method,json=rewardMethod,proto3" json:"reward_method,omitempty"`
 }
 
@@ -337,6 +573,7 @@ func (x *CreateWorkerRequest) GetIp() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/miners.proto.
 func (x *CreateWorkerRequest) GetIsSolo() bool {
 	if x != nil {
 		return x.IsSolo
@@ -618,18 +855,22 @@ func (x *GetWorkerIDByNameResponse) GetId() int64 {
 	return 0
 }
 
-// Сообщение для деталей ошибки
-type MPError struct {
+// Идентификационные данные воркера в том виде, как они приходят с пул-сервера
+type MinerIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Method      string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`           // метод, где возникла ошибка
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // описание
+	Coin         string `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`                                     // символ монеты
+	Wallet       string `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`                                 // имя кошелька (майнера)
+	Workerfull   string `protobuf:"bytes,3,opt,name=workerfull,proto3" json:"workerfull,omitempty"`                         // полное имя воркера (wallet.worker)
+	ServerId     string `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`             // идентификатор пул-сервера
+	Ip           string `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`                                         // IP адрес воркера
+	RewardMethod string `protobuf:"bytes,6,opt,name=reward_method,json=rewardMethod,proto3" json:"reward_method,omitempty"` // метод начисления вознаграждения
 }
 
-func (x *MPError) Reset() {
-	*x = MPError{}
+func (x *MinerIdentity) Reset() {
+	*x = MinerIdentity{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_miners_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -637,13 +878,13 @@ func (x *MPError) Reset() {
 	}
 }
 
-func (x *MPError) String() string {
+func (x *MinerIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MPError) ProtoMessage() {}
+func (*MinerIdentity) ProtoMessage() {}
 
-func (x *MPError) ProtoReflect() protoreflect.Message {
+func (x *MinerIdentity) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_miners_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,222 +896,5533 @@ func (x *MPError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MPError.ProtoReflect.Descriptor instead.
-func (*MPError) Descriptor() ([]byte, []int) {
+// Deprecated: Use MinerIdentity.ProtoReflect.Descriptor instead.
+func (*MinerIdentity) Descriptor() ([]byte, []int) {
 	return file_proto_miners_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MPError) GetMethod() string {
+func (x *MinerIdentity) GetCoin() string {
 	if x != nil {
-		return x.Method
+		return x.Coin
 	}
 	return ""
 }
 
-func (x *MPError) GetDescription() string {
+func (x *MinerIdentity) GetWallet() string {
 	if x != nil {
-		return x.Description
+		return x.Wallet
 	}
 	return ""
 }
 
-var File_proto_miners_proto protoreflect.FileDescriptor
-
-var file_proto_miners_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x72, 0x70, 0x63, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x69, 0x6e, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x69, 0x6e, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63,
-	0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f,
-	0x69, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x73,
-	0x6f, 0x6c, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x53, 0x6f, 0x6c,
-	0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf9,
-	0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12,
-	0x1e, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x12,
-	0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12,
-	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x17, 0x0a, 0x07,
-	0x69, 0x73, 0x5f, 0x73, 0x6f, 0x6c, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69,
-	0x73, 0x53, 0x6f, 0x6c, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x70, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49,
-	0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x78, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44,
-	0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x17, 0x0a,
-	0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x07, 0x4d, 0x50, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x99, 0x03,
-	0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x4e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69,
-	0x6e, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x49,
-	0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x45, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12,
-	0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1d, 0x5a, 0x1b, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2f, 0x67, 0x72,
-	0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *MinerIdentity) GetWorkerfull() string {
+	if x != nil {
+		return x.Workerfull
+	}
+	return ""
 }
 
-var (
-	file_proto_miners_proto_rawDescOnce sync.Once
-	file_proto_miners_proto_rawDescData = file_proto_miners_proto_rawDesc
-)
+func (x *MinerIdentity) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
 
-func file_proto_miners_proto_rawDescGZIP() []byte {
-	file_proto_miners_proto_rawDescOnce.Do(func() {
-		file_proto_miners_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_miners_proto_rawDescData)
-	})
-	return file_proto_miners_proto_rawDescData
+func (x *MinerIdentity) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
 }
 
-var file_proto_miners_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_proto_miners_proto_goTypes = []interface{}{
-	(*GetCoinIDByNameRequest)(nil),    // 0: grpc.GetCoinIDByNameRequest
-	(*GetCoinIDByNameResponse)(nil),   // 1: grpc.GetCoinIDByNameResponse
-	(*CreateWalletRequest)(nil),       // 2: grpc.CreateWalletRequest
-	(*CreateWalletResponse)(nil),      // 3: grpc.CreateWalletResponse
-	(*CreateWorkerRequest)(nil),       // 4: grpc.CreateWorkerRequest
-	(*CreateWorkerResponse)(nil),      // 5: grpc.CreateWorkerResponse
-	(*GetWalletIDByNameRequest)(nil),  // 6: grpc.GetWalletIDByNameRequest
-	(*GetWalletIDByNameResponse)(nil), // 7: grpc.GetWalletIDByNameResponse
-	(*GetWorkerIDByNameRequest)(nil),  // 8: grpc.GetWorkerIDByNameRequest
-	(*GetWorkerIDByNameResponse)(nil), // 9: grpc.GetWorkerIDByNameResponse
-	(*MPError)(nil),                   // 10: grpc.MPError
+func (x *MinerIdentity) GetRewardMethod() string {
+	if x != nil {
+		return x.RewardMethod
+	}
+	return ""
 }
-var file_proto_miners_proto_depIdxs = []int32{
-	0, // 0: grpc.MinersService.GetCoinIDByName:input_type -> grpc.GetCoinIDByNameRequest
-	2, // 1: grpc.MinersService.CreateWallet:input_type -> grpc.CreateWalletRequest
-	4, // 2: grpc.MinersService.CreateWorker:input_type -> grpc.CreateWorkerRequest
-	6, // 3: grpc.MinersService.GetWalletIDByName:input_type -> grpc.GetWalletIDByNameRequest
-	8, // 4: grpc.MinersService.GetWorkerIDByName:input_type -> grpc.GetWorkerIDByNameRequest
-	1, // 5: grpc.MinersService.GetCoinIDByName:output_type -> grpc.GetCoinIDByNameResponse
-	3, // 6: grpc.MinersService.CreateWallet:output_type -> grpc.CreateWalletResponse
-	5, // 7: grpc.MinersService.CreateWorker:output_type -> grpc.CreateWorkerResponse
-	7, // 8: grpc.MinersService.GetWalletIDByName:output_type -> grpc.GetWalletIDByNameResponse
-	9, // 9: grpc.MinersService.GetWorkerIDByName:output_type -> grpc.GetWorkerIDByNameResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+type ResolveMinersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Miners []*MinerIdentity `protobuf:"bytes,1,rep,name=miners,proto3" json:"miners,omitempty"`
 }
 
-func init() { file_proto_miners_proto_init() }
-func file_proto_miners_proto_init() {
-	if File_proto_miners_proto != nil {
-		return
+func (x *ResolveMinersRequest) Reset() {
+	*x = ResolveMinersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_miners_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCoinIDByNameRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_miners_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCoinIDByNameResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_miners_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWalletRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *ResolveMinersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMinersRequest) ProtoMessage() {}
+
+func (x *ResolveMinersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_miners_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWalletResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMinersRequest.ProtoReflect.Descriptor instead.
+func (*ResolveMinersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResolveMinersRequest) GetMiners() []*MinerIdentity {
+	if x != nil {
+		return x.Miners
+	}
+	return nil
+}
+
+// ID для одного элемента запроса (0 у всех полей - монета не найдена)
+// coin_id = 0 - монета не найдена или выключена;
+// wallet_id = worker_id = 0 при заполненном coin_id - метод начисления недоступен для монеты (кошелек и воркер не создаются)
+type ResolvedMiner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoinId   int64 `protobuf:"varint,1,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	WalletId int64 `protobuf:"varint,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	WorkerId int64 `protobuf:"varint,3,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+}
+
+func (x *ResolvedMiner) Reset() {
+	*x = ResolvedMiner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolvedMiner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedMiner) ProtoMessage() {}
+
+func (x *ResolvedMiner) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_miners_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWorkerRequest); i {
-			case 0:
-				return &v.state
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedMiner.ProtoReflect.Descriptor instead.
+func (*ResolvedMiner) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResolvedMiner) GetCoinId() int64 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+func (x *ResolvedMiner) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *ResolvedMiner) GetWorkerId() int64 {
+	if x != nil {
+		return x.WorkerId
+	}
+	return 0
+}
+
+type ResolveMinersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Miners []*ResolvedMiner `protobuf:"bytes,1,rep,name=miners,proto3" json:"miners,omitempty"` // в том же порядке, что и в запросе
+}
+
+func (x *ResolveMinersResponse) Reset() {
+	*x = ResolveMinersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveMinersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMinersResponse) ProtoMessage() {}
+
+func (x *ResolveMinersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMinersResponse.ProtoReflect.Descriptor instead.
+func (*ResolveMinersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResolveMinersResponse) GetMiners() []*ResolvedMiner {
+	if x != nil {
+		return x.Miners
+	}
+	return nil
+}
+
+type ListRewardMethodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoinId int64 `protobuf:"varint,1,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+}
+
+func (x *ListRewardMethodsRequest) Reset() {
+	*x = ListRewardMethodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRewardMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRewardMethodsRequest) ProtoMessage() {}
+
+func (x *ListRewardMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRewardMethodsRequest.ProtoReflect.Descriptor instead.
+func (*ListRewardMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListRewardMethodsRequest) GetCoinId() int64 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+type RewardMethodInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method RewardMethod `protobuf:"varint,1,opt,name=method,proto3,enum=grpc.RewardMethod" json:"method,omitempty"`
+	Code   string       `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // строковый код (как в reward_method запросов)
+	Name   string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // название для отображения
+}
+
+func (x *RewardMethodInfo) Reset() {
+	*x = RewardMethodInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardMethodInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardMethodInfo) ProtoMessage() {}
+
+func (x *RewardMethodInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardMethodInfo.ProtoReflect.Descriptor instead.
+func (*RewardMethodInfo) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RewardMethodInfo) GetMethod() RewardMethod {
+	if x != nil {
+		return x.Method
+	}
+	return RewardMethod_REWARD_METHOD_UNSPECIFIED
+}
+
+func (x *RewardMethodInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RewardMethodInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListRewardMethodsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Methods []*RewardMethodInfo `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (x *ListRewardMethodsResponse) Reset() {
+	*x = ListRewardMethodsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRewardMethodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRewardMethodsResponse) ProtoMessage() {}
+
+func (x *ListRewardMethodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRewardMethodsResponse.ProtoReflect.Descriptor instead.
+func (*ListRewardMethodsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListRewardMethodsResponse) GetMethods() []*RewardMethodInfo {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type GetWorkerIPHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerId int64 `protobuf:"varint,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Limit    int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // максимальное количество записей (0 или больше допустимого - максимально допустимое)
+}
+
+func (x *GetWorkerIPHistoryRequest) Reset() {
+	*x = GetWorkerIPHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkerIPHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerIPHistoryRequest) ProtoMessage() {}
+
+func (x *GetWorkerIPHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerIPHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkerIPHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetWorkerIPHistoryRequest) GetWorkerId() int64 {
+	if x != nil {
+		return x.WorkerId
+	}
+	return 0
+}
+
+func (x *GetWorkerIPHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type WorkerIP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip        string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	FirstSeen int64  `protobuf:"varint,2,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"` // первое подключение с адреса (unix time в миллисекундах)
+	LastSeen  int64  `protobuf:"varint,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`    // последнее подключение с адреса (unix time в миллисекундах)
+}
+
+func (x *WorkerIP) Reset() {
+	*x = WorkerIP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerIP) ProtoMessage() {}
+
+func (x *WorkerIP) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerIP.ProtoReflect.Descriptor instead.
+func (*WorkerIP) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WorkerIP) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *WorkerIP) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *WorkerIP) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+type GetWorkerIPHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	History []*WorkerIP `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"` // последние подключения сначала
+}
+
+func (x *GetWorkerIPHistoryResponse) Reset() {
+	*x = GetWorkerIPHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkerIPHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerIPHistoryResponse) ProtoMessage() {}
+
+func (x *GetWorkerIPHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerIPHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkerIPHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetWorkerIPHistoryResponse) GetHistory() []*WorkerIP {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Все условия отбора необязательны (нулевое значение - без ограничения)
+type ListWalletsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoinId       int64           `protobuf:"varint,1,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	RewardMethod string          `protobuf:"bytes,2,opt,name=reward_method,json=rewardMethod,proto3" json:"reward_method,omitempty"`     // код метода начисления вознаграждения
+	NamePrefix   string          `protobuf:"bytes,3,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`           // начало имени кошелька (с учетом регистра)
+	MinHashrate  *int64          `protobuf:"varint,4,opt,name=min_hashrate,json=minHashrate,proto3,oneof" json:"min_hashrate,omitempty"` // текущий хешрейт от (включительно)
+	MaxHashrate  *int64          `protobuf:"varint,5,opt,name=max_hashrate,json=maxHashrate,proto3,oneof" json:"max_hashrate,omitempty"` // текущий хешрейт до (включительно)
+	CreatedFrom  int64           `protobuf:"varint,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`       // создан не раньше (unix time в миллисекундах)
+	CreatedTo    int64           `protobuf:"varint,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`             // создан раньше (unix time в миллисекундах)
+	Sort         WalletSortField `protobuf:"varint,8,opt,name=sort,proto3,enum=grpc.WalletSortField" json:"sort,omitempty"`
+	Desc         bool            `protobuf:"varint,9,opt,name=desc,proto3" json:"desc,omitempty"`                            // сортировка по убыванию
+	PageSize     int32           `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // размер страницы (0 - по умолчанию, больше допустимого - максимально допустимый)
+	PageToken    string          `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token предыдущей страницы (условия отбора и сортировка должны совпадать)
+}
+
+func (x *ListWalletsRequest) Reset() {
+	*x = ListWalletsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWalletsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletsRequest) ProtoMessage() {}
+
+func (x *ListWalletsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletsRequest.ProtoReflect.Descriptor instead.
+func (*ListWalletsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListWalletsRequest) GetCoinId() int64 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+func (x *ListWalletsRequest) GetRewardMethod() string {
+	if x != nil {
+		return x.RewardMethod
+	}
+	return ""
+}
+
+func (x *ListWalletsRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListWalletsRequest) GetMinHashrate() int64 {
+	if x != nil && x.MinHashrate != nil {
+		return *x.MinHashrate
+	}
+	return 0
+}
+
+func (x *ListWalletsRequest) GetMaxHashrate() int64 {
+	if x != nil && x.MaxHashrate != nil {
+		return *x.MaxHashrate
+	}
+	return 0
+}
+
+func (x *ListWalletsRequest) GetCreatedFrom() int64 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *ListWalletsRequest) GetCreatedTo() int64 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *ListWalletsRequest) GetSort() WalletSortField {
+	if x != nil {
+		return x.Sort
+	}
+	return WalletSortField_WALLET_SORT_ID
+}
+
+func (x *ListWalletsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *ListWalletsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWalletsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type WalletInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CoinId           int64    `protobuf:"varint,2,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	Name             string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RewardMethod     string   `protobuf:"bytes,4,opt,name=reward_method,json=rewardMethod,proto3" json:"reward_method,omitempty"`
+	CurrentHashrate  int64    `protobuf:"varint,5,opt,name=current_hashrate,json=currentHashrate,proto3" json:"current_hashrate,omitempty"`
+	AverageHashrate  int64    `protobuf:"varint,6,opt,name=average_hashrate,json=averageHashrate,proto3" json:"average_hashrate,omitempty"`
+	CreatedAt        int64    `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                     // unix time в миллисекундах (UTC)
+	PaymentThreshold *Decimal `protobuf:"bytes,9,opt,name=payment_threshold,json=paymentThreshold,proto3" json:"payment_threshold,omitempty"` // порог выплаты (0 - не задан, выплата от min_withdraw монеты)
+}
+
+func (x *WalletInfo) Reset() {
+	*x = WalletInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletInfo) ProtoMessage() {}
+
+func (x *WalletInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletInfo.ProtoReflect.Descriptor instead.
+func (*WalletInfo) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WalletInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WalletInfo) GetCoinId() int64 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+func (x *WalletInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WalletInfo) GetRewardMethod() string {
+	if x != nil {
+		return x.RewardMethod
+	}
+	return ""
+}
+
+func (x *WalletInfo) GetCurrentHashrate() int64 {
+	if x != nil {
+		return x.CurrentHashrate
+	}
+	return 0
+}
+
+func (x *WalletInfo) GetAverageHashrate() int64 {
+	if x != nil {
+		return x.AverageHashrate
+	}
+	return 0
+}
+
+func (x *WalletInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WalletInfo) GetPaymentThreshold() *Decimal {
+	if x != nil {
+		return x.PaymentThreshold
+	}
+	return nil
+}
+
+type GetWalletRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletRequest) ProtoMessage() {}
+
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetWalletRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetWalletResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wallet *WalletInfo `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+}
+
+func (x *GetWalletResponse) Reset() {
+	*x = GetWalletResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletResponse) ProtoMessage() {}
+
+func (x *GetWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetWalletResponse) GetWallet() *WalletInfo {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+type GetPaymentThresholdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletId int64 `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (x *GetPaymentThresholdRequest) Reset() {
+	*x = GetPaymentThresholdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPaymentThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentThresholdRequest) ProtoMessage() {}
+
+func (x *GetPaymentThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentThresholdRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPaymentThresholdRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+type GetPaymentThresholdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentThreshold          *Decimal `protobuf:"bytes,5,opt,name=payment_threshold,json=paymentThreshold,proto3" json:"payment_threshold,omitempty"`                              // порог кошелька (0 - не задан)
+	EffectivePaymentThreshold *Decimal `protobuf:"bytes,6,opt,name=effective_payment_threshold,json=effectivePaymentThreshold,proto3" json:"effective_payment_threshold,omitempty"` // порог, от которого выполняется выплата (не задан - min_withdraw монеты)
+	MinWithdraw               *Decimal `protobuf:"bytes,7,opt,name=min_withdraw,json=minWithdraw,proto3" json:"min_withdraw,omitempty"`                                             // минимальная сумма выплаты монеты
+	MaxPaymentThreshold       *Decimal `protobuf:"bytes,8,opt,name=max_payment_threshold,json=maxPaymentThreshold,proto3" json:"max_payment_threshold,omitempty"`                   // максимальный порог монеты (не задан - без ограничения)
+}
+
+func (x *GetPaymentThresholdResponse) Reset() {
+	*x = GetPaymentThresholdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPaymentThresholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentThresholdResponse) ProtoMessage() {}
+
+func (x *GetPaymentThresholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentThresholdResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentThresholdResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPaymentThresholdResponse) GetPaymentThreshold() *Decimal {
+	if x != nil {
+		return x.PaymentThreshold
+	}
+	return nil
+}
+
+func (x *GetPaymentThresholdResponse) GetEffectivePaymentThreshold() *Decimal {
+	if x != nil {
+		return x.EffectivePaymentThreshold
+	}
+	return nil
+}
+
+func (x *GetPaymentThresholdResponse) GetMinWithdraw() *Decimal {
+	if x != nil {
+		return x.MinWithdraw
+	}
+	return nil
+}
+
+func (x *GetPaymentThresholdResponse) GetMaxPaymentThreshold() *Decimal {
+	if x != nil {
+		return x.MaxPaymentThreshold
+	}
+	return nil
+}
+
+type SetPaymentThresholdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletId         int64    `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	PaymentThreshold *Decimal `protobuf:"bytes,4,opt,name=payment_threshold,json=paymentThreshold,proto3" json:"payment_threshold,omitempty"` // 0 - сбросить (выплата от min_withdraw монеты)
+	Actor            string   `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                                               // пользователь, от имени которого выполняется изменение (для журнала)
+}
+
+func (x *SetPaymentThresholdRequest) Reset() {
+	*x = SetPaymentThresholdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPaymentThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPaymentThresholdRequest) ProtoMessage() {}
+
+func (x *SetPaymentThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPaymentThresholdRequest.ProtoReflect.Descriptor instead.
+func (*SetPaymentThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetPaymentThresholdRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *SetPaymentThresholdRequest) GetPaymentThreshold() *Decimal {
+	if x != nil {
+		return x.PaymentThreshold
+	}
+	return nil
+}
+
+func (x *SetPaymentThresholdRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type SetPaymentThresholdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentThreshold          *Decimal `protobuf:"bytes,4,opt,name=payment_threshold,json=paymentThreshold,proto3" json:"payment_threshold,omitempty"` // порог после изменения
+	EffectivePaymentThreshold *Decimal `protobuf:"bytes,5,opt,name=effective_payment_threshold,json=effectivePaymentThreshold,proto3" json:"effective_payment_threshold,omitempty"`
+	Changed                   bool     `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"` // false - значение не изменилось (в журнал не записано)
+}
+
+func (x *SetPaymentThresholdResponse) Reset() {
+	*x = SetPaymentThresholdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPaymentThresholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPaymentThresholdResponse) ProtoMessage() {}
+
+func (x *SetPaymentThresholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPaymentThresholdResponse.ProtoReflect.Descriptor instead.
+func (*SetPaymentThresholdResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetPaymentThresholdResponse) GetPaymentThreshold() *Decimal {
+	if x != nil {
+		return x.PaymentThreshold
+	}
+	return nil
+}
+
+func (x *SetPaymentThresholdResponse) GetEffectivePaymentThreshold() *Decimal {
+	if x != nil {
+		return x.EffectivePaymentThreshold
+	}
+	return nil
+}
+
+func (x *SetPaymentThresholdResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+type ListPaymentThresholdChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletId int64 `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Limit    int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // максимальное количество записей (0 или больше допустимого - максимально допустимое)
+}
+
+func (x *ListPaymentThresholdChangesRequest) Reset() {
+	*x = ListPaymentThresholdChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPaymentThresholdChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentThresholdChangesRequest) ProtoMessage() {}
+
+func (x *ListPaymentThresholdChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentThresholdChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentThresholdChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListPaymentThresholdChangesRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *ListPaymentThresholdChangesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PaymentThresholdChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldValue  *Decimal `protobuf:"bytes,6,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue  *Decimal `protobuf:"bytes,7,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	Service   string   `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`                       // сервис, выполнивший изменение
+	Actor     string   `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`                           // пользователь, от имени которого выполнено изменение
+	ChangedAt int64    `protobuf:"varint,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"` // unix time в миллисекундах
+}
+
+func (x *PaymentThresholdChange) Reset() {
+	*x = PaymentThresholdChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentThresholdChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentThresholdChange) ProtoMessage() {}
+
+func (x *PaymentThresholdChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentThresholdChange.ProtoReflect.Descriptor instead.
+func (*PaymentThresholdChange) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PaymentThresholdChange) GetOldValue() *Decimal {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *PaymentThresholdChange) GetNewValue() *Decimal {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+func (x *PaymentThresholdChange) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *PaymentThresholdChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *PaymentThresholdChange) GetChangedAt() int64 {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return 0
+}
+
+type ListPaymentThresholdChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*PaymentThresholdChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"` // последние сначала
+}
+
+func (x *ListPaymentThresholdChangesResponse) Reset() {
+	*x = ListPaymentThresholdChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPaymentThresholdChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentThresholdChangesResponse) ProtoMessage() {}
+
+func (x *ListPaymentThresholdChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentThresholdChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentThresholdChangesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListPaymentThresholdChangesResponse) GetChanges() []*PaymentThresholdChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type RequestSettingsChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletId         int64    `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	PaymentThreshold *Decimal `protobuf:"bytes,4,opt,name=payment_threshold,json=paymentThreshold,proto3" json:"payment_threshold,omitempty"` // новый порог выплаты (0 - сбросить)
+	Actor            string   `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                                               // пользователь, запросивший изменение (для журнала)
+}
+
+func (x *RequestSettingsChangeRequest) Reset() {
+	*x = RequestSettingsChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestSettingsChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestSettingsChangeRequest) ProtoMessage() {}
+
+func (x *RequestSettingsChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestSettingsChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestSettingsChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RequestSettingsChangeRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *RequestSettingsChangeRequest) GetPaymentThreshold() *Decimal {
+	if x != nil {
+		return x.PaymentThreshold
+	}
+	return nil
+}
+
+func (x *RequestSettingsChangeRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type RequestSettingsChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeId          int64  `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	ConfirmationToken string `protobuf:"bytes,2,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"` // одноразовый токен (сервис доставки передает его майнеру, в сервисе хранится только хеш)
+	ExpiresAt         int64  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                        // unix time в миллисекундах, после которого токен недействителен
+}
+
+func (x *RequestSettingsChangeResponse) Reset() {
+	*x = RequestSettingsChangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestSettingsChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestSettingsChangeResponse) ProtoMessage() {}
+
+func (x *RequestSettingsChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestSettingsChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestSettingsChangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RequestSettingsChangeResponse) GetChangeId() int64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *RequestSettingsChangeResponse) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+func (x *RequestSettingsChangeResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ConfirmSettingsChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ConfirmSettingsChangeRequest) Reset() {
+	*x = ConfirmSettingsChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmSettingsChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSettingsChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmSettingsChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSettingsChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmSettingsChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ConfirmSettingsChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ConfirmSettingsChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletId         int64    `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	PaymentThreshold *Decimal `protobuf:"bytes,3,opt,name=payment_threshold,json=paymentThreshold,proto3" json:"payment_threshold,omitempty"` // порог после изменения
+}
+
+func (x *ConfirmSettingsChangeResponse) Reset() {
+	*x = ConfirmSettingsChangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmSettingsChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSettingsChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmSettingsChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSettingsChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmSettingsChangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConfirmSettingsChangeResponse) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *ConfirmSettingsChangeResponse) GetPaymentThreshold() *Decimal {
+	if x != nil {
+		return x.PaymentThreshold
+	}
+	return nil
+}
+
+type ListWalletsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wallets       []*WalletInfo `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // пустой - страница последняя
+}
+
+func (x *ListWalletsResponse) Reset() {
+	*x = ListWalletsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWalletsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletsResponse) ProtoMessage() {}
+
+func (x *ListWalletsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletsResponse.ProtoReflect.Descriptor instead.
+func (*ListWalletsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListWalletsResponse) GetWallets() []*WalletInfo {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *ListWalletsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListWorkersByWalletRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wallet       string             `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	CoinId       int64              `protobuf:"varint,2,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	RewardMethod string             `protobuf:"bytes,3,opt,name=reward_method,json=rewardMethod,proto3" json:"reward_method,omitempty"`
+	Status       WorkerStatusFilter `protobuf:"varint,4,opt,name=status,proto3,enum=grpc.WorkerStatusFilter" json:"status,omitempty"`
+	PageSize     int32              `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // размер страницы (0 - по умолчанию, больше допустимого - максимально допустимый)
+	PageToken    string             `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token предыдущей страницы
+}
+
+func (x *ListWorkersByWalletRequest) Reset() {
+	*x = ListWorkersByWalletRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkersByWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkersByWalletRequest) ProtoMessage() {}
+
+func (x *ListWorkersByWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkersByWalletRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkersByWalletRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListWorkersByWalletRequest) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *ListWorkersByWalletRequest) GetCoinId() int64 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+func (x *ListWorkersByWalletRequest) GetRewardMethod() string {
+	if x != nil {
+		return x.RewardMethod
+	}
+	return ""
+}
+
+func (x *ListWorkersByWalletRequest) GetStatus() WorkerStatusFilter {
+	if x != nil {
+		return x.Status
+	}
+	return WorkerStatusFilter_WORKER_STATUS_ALL
+}
+
+func (x *ListWorkersByWalletRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWorkersByWalletRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type WorkerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Workerfull           string   `protobuf:"bytes,2,opt,name=workerfull,proto3" json:"workerfull,omitempty"`
+	Worker               string   `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	ServerId             string   `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Ip                   string   `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	IsConnect            bool     `protobuf:"varint,6,opt,name=is_connect,json=isConnect,proto3" json:"is_connect,omitempty"` // воркер подключен (онлайн)
+	CurrentHashrate      int64    `protobuf:"varint,7,opt,name=current_hashrate,json=currentHashrate,proto3" json:"current_hashrate,omitempty"`
+	AverageHashrate      int64    `protobuf:"varint,8,opt,name=average_hashrate,json=averageHashrate,proto3" json:"average_hashrate,omitempty"`
+	LastShareDate        int64    `protobuf:"varint,9,opt,name=last_share_date,json=lastShareDate,proto3" json:"last_share_date,omitempty"`                       // время последней шары (unix time в миллисекундах, 0 - шар не было)
+	CurrentDiff          *Decimal `protobuf:"bytes,14,opt,name=current_diff,json=currentDiff,proto3" json:"current_diff,omitempty"`                               // текущая сложность
+	MinerClient          string   `protobuf:"bytes,11,opt,name=miner_client,json=minerClient,proto3" json:"miner_client,omitempty"`                               // майнинговая программа
+	ReportedHashrate     int64    `protobuf:"varint,12,opt,name=reported_hashrate,json=reportedHashrate,proto3" json:"reported_hashrate,omitempty"`               // хешрейт по данным майнинговой программы
+	ReportedHashrateDate int64    `protobuf:"varint,13,opt,name=reported_hashrate_date,json=reportedHashrateDate,proto3" json:"reported_hashrate_date,omitempty"` // время получения reported_hashrate (unix time в миллисекундах, 0 - не передавался)
+}
+
+func (x *WorkerInfo) Reset() {
+	*x = WorkerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerInfo) ProtoMessage() {}
+
+func (x *WorkerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerInfo.ProtoReflect.Descriptor instead.
+func (*WorkerInfo) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WorkerInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkerInfo) GetWorkerfull() string {
+	if x != nil {
+		return x.Workerfull
+	}
+	return ""
+}
+
+func (x *WorkerInfo) GetWorker() string {
+	if x != nil {
+		return x.Worker
+	}
+	return ""
+}
+
+func (x *WorkerInfo) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *WorkerInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *WorkerInfo) GetIsConnect() bool {
+	if x != nil {
+		return x.IsConnect
+	}
+	return false
+}
+
+func (x *WorkerInfo) GetCurrentHashrate() int64 {
+	if x != nil {
+		return x.CurrentHashrate
+	}
+	return 0
+}
+
+func (x *WorkerInfo) GetAverageHashrate() int64 {
+	if x != nil {
+		return x.AverageHashrate
+	}
+	return 0
+}
+
+func (x *WorkerInfo) GetLastShareDate() int64 {
+	if x != nil {
+		return x.LastShareDate
+	}
+	return 0
+}
+
+func (x *WorkerInfo) GetCurrentDiff() *Decimal {
+	if x != nil {
+		return x.CurrentDiff
+	}
+	return nil
+}
+
+func (x *WorkerInfo) GetMinerClient() string {
+	if x != nil {
+		return x.MinerClient
+	}
+	return ""
+}
+
+func (x *WorkerInfo) GetReportedHashrate() int64 {
+	if x != nil {
+		return x.ReportedHashrate
+	}
+	return 0
+}
+
+func (x *WorkerInfo) GetReportedHashrateDate() int64 {
+	if x != nil {
+		return x.ReportedHashrateDate
+	}
+	return 0
+}
+
+type ListWorkersByWalletResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workers       []*WorkerInfo `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`                                    // по возрастанию id
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // пустой - страница последняя
+}
+
+func (x *ListWorkersByWalletResponse) Reset() {
+	*x = ListWorkersByWalletResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkersByWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkersByWalletResponse) ProtoMessage() {}
+
+func (x *ListWorkersByWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkersByWalletResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkersByWalletResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListWorkersByWalletResponse) GetWorkers() []*WorkerInfo {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+func (x *ListWorkersByWalletResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Текущее состояние воркера
+type WorkerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerId         int64    `protobuf:"varint,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	IsConnect        bool     `protobuf:"varint,2,opt,name=is_connect,json=isConnect,proto3" json:"is_connect,omitempty"`
+	CurrentHashrate  int64    `protobuf:"varint,3,opt,name=current_hashrate,json=currentHashrate,proto3" json:"current_hashrate,omitempty"`
+	AverageHashrate  int64    `protobuf:"varint,4,opt,name=average_hashrate,json=averageHashrate,proto3" json:"average_hashrate,omitempty"`
+	LastShareDate    int64    `protobuf:"varint,5,opt,name=last_share_date,json=lastShareDate,proto3" json:"last_share_date,omitempty"`              // unix time в миллисекундах (0 - не изменяется)
+	CurrentDiff      *Decimal `protobuf:"bytes,8,opt,name=current_diff,json=currentDiff,proto3" json:"current_diff,omitempty"`                       // не задана - не изменяется
+	ReportedHashrate *int64   `protobuf:"varint,7,opt,name=reported_hashrate,json=reportedHashrate,proto3,oneof" json:"reported_hashrate,omitempty"` // хешрейт по данным майнинговой программы (не задан - не изменяется)
+}
+
+func (x *WorkerStats) Reset() {
+	*x = WorkerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerStats) ProtoMessage() {}
+
+func (x *WorkerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerStats.ProtoReflect.Descriptor instead.
+func (*WorkerStats) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WorkerStats) GetWorkerId() int64 {
+	if x != nil {
+		return x.WorkerId
+	}
+	return 0
+}
+
+func (x *WorkerStats) GetIsConnect() bool {
+	if x != nil {
+		return x.IsConnect
+	}
+	return false
+}
+
+func (x *WorkerStats) GetCurrentHashrate() int64 {
+	if x != nil {
+		return x.CurrentHashrate
+	}
+	return 0
+}
+
+func (x *WorkerStats) GetAverageHashrate() int64 {
+	if x != nil {
+		return x.AverageHashrate
+	}
+	return 0
+}
+
+func (x *WorkerStats) GetLastShareDate() int64 {
+	if x != nil {
+		return x.LastShareDate
+	}
+	return 0
+}
+
+func (x *WorkerStats) GetCurrentDiff() *Decimal {
+	if x != nil {
+		return x.CurrentDiff
+	}
+	return nil
+}
+
+func (x *WorkerStats) GetReportedHashrate() int64 {
+	if x != nil && x.ReportedHashrate != nil {
+		return *x.ReportedHashrate
+	}
+	return 0
+}
+
+type UpdateWorkerStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats []*WorkerStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"` // для повторяющихся воркеров применяется последнее состояние
+}
+
+func (x *UpdateWorkerStatsRequest) Reset() {
+	*x = UpdateWorkerStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWorkerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkerStatsRequest) ProtoMessage() {}
+
+func (x *UpdateWorkerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkerStatsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateWorkerStatsRequest) GetStats() []*WorkerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type UpdateWorkerStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated int32 `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"` // количество обновленных воркеров (неизвестные worker_id пропускаются)
+}
+
+func (x *UpdateWorkerStatsResponse) Reset() {
+	*x = UpdateWorkerStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWorkerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkerStatsResponse) ProtoMessage() {}
+
+func (x *UpdateWorkerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkerStatsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateWorkerStatsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// Все условия отбора необязательны (нулевое значение - без ограничения)
+// Не успевающий читать события клиент отключается с codes.ResourceExhausted,
+// после переподключения состояние воркеров нужно сверить через ListWorkersByWallet
+type WatchWorkerStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoinId int64  `protobuf:"varint,1,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	Wallet string `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+}
+
+func (x *WatchWorkerStatusRequest) Reset() {
+	*x = WatchWorkerStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchWorkerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWorkerStatusRequest) ProtoMessage() {}
+
+func (x *WatchWorkerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWorkerStatusRequest.ProtoReflect.Descriptor instead.
+func (*WatchWorkerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WatchWorkerStatusRequest) GetCoinId() int64 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+func (x *WatchWorkerStatusRequest) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+type WorkerStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerId      int64  `protobuf:"varint,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	CoinId        int64  `protobuf:"varint,2,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	Wallet        string `protobuf:"bytes,3,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Workerfull    string `protobuf:"bytes,4,opt,name=workerfull,proto3" json:"workerfull,omitempty"`
+	RewardMethod  string `protobuf:"bytes,5,opt,name=reward_method,json=rewardMethod,proto3" json:"reward_method,omitempty"`
+	Online        bool   `protobuf:"varint,6,opt,name=online,proto3" json:"online,omitempty"`                                      // true - воркер подключился, false - отключился
+	LastShareDate int64  `protobuf:"varint,7,opt,name=last_share_date,json=lastShareDate,proto3" json:"last_share_date,omitempty"` // время последней шары (unix time в миллисекундах, 0 - шар не было)
+	Time          int64  `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`                                          // время изменения состояния (unix time в миллисекундах)
+}
+
+func (x *WorkerStatusEvent) Reset() {
+	*x = WorkerStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerStatusEvent) ProtoMessage() {}
+
+func (x *WorkerStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerStatusEvent.ProtoReflect.Descriptor instead.
+func (*WorkerStatusEvent) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WorkerStatusEvent) GetWorkerId() int64 {
+	if x != nil {
+		return x.WorkerId
+	}
+	return 0
+}
+
+func (x *WorkerStatusEvent) GetCoinId() int64 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+func (x *WorkerStatusEvent) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *WorkerStatusEvent) GetWorkerfull() string {
+	if x != nil {
+		return x.Workerfull
+	}
+	return ""
+}
+
+func (x *WorkerStatusEvent) GetRewardMethod() string {
+	if x != nil {
+		return x.RewardMethod
+	}
+	return ""
+}
+
+func (x *WorkerStatusEvent) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *WorkerStatusEvent) GetLastShareDate() int64 {
+	if x != nil {
+		return x.LastShareDate
+	}
+	return 0
+}
+
+func (x *WorkerStatusEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// Монета (десятичные значения передаются сообщением Decimal без потери точности)
+// Статистические поля (average_*, current_effort, last_reward_processed_id) заполняются другими сервисами
+// и через CreateCoin/UpdateCoin не изменяются
+type Coin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol                string      `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`   // символ (тикер)
+	Symbol2               string      `protobuf:"bytes,3,opt,name=symbol2,proto3" json:"symbol2,omitempty"` // альтернативный символ
+	Name                  string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Algo                  string      `protobuf:"bytes,5,opt,name=algo,proto3" json:"algo,omitempty"` // алгоритм майнинга
+	Image                 string      `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`
+	TransactionsExplorer  string      `protobuf:"bytes,8,opt,name=transactions_explorer,json=transactionsExplorer,proto3" json:"transactions_explorer,omitempty"`
+	BlockExplorer         string      `protobuf:"bytes,9,opt,name=block_explorer,json=blockExplorer,proto3" json:"block_explorer,omitempty"`
+	IsActive              bool        `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Params                *CoinParams `protobuf:"bytes,11,opt,name=params,proto3" json:"params,omitempty"` // дополнительные параметры (через UpdateCoin не изменяются, см. PatchCoinParams)
+	SeoTitle              string      `protobuf:"bytes,19,opt,name=seo_title,json=seoTitle,proto3" json:"seo_title,omitempty"`
+	LastRewardProcessedId int64       `protobuf:"varint,20,opt,name=last_reward_processed_id,json=lastRewardProcessedId,proto3" json:"last_reward_processed_id,omitempty"`
+	MinWithdraw           *Decimal    `protobuf:"bytes,22,opt,name=min_withdraw,json=minWithdraw,proto3" json:"min_withdraw,omitempty"` // минимальная сумма выплаты (не задана - 0)
+	AverageRoundDiff      *Decimal    `protobuf:"bytes,23,opt,name=average_round_diff,json=averageRoundDiff,proto3" json:"average_round_diff,omitempty"`
+	AverageSoloRoundDiff  *Decimal    `protobuf:"bytes,24,opt,name=average_solo_round_diff,json=averageSoloRoundDiff,proto3" json:"average_solo_round_diff,omitempty"`
+	CurrentEffort         *Decimal    `protobuf:"bytes,25,opt,name=current_effort,json=currentEffort,proto3" json:"current_effort,omitempty"`
+	CoinsInBlock          *Decimal    `protobuf:"bytes,26,opt,name=coins_in_block,json=coinsInBlock,proto3" json:"coins_in_block,omitempty"` // награда за блок (не задана - 0)
+	AveragePpsRoundDiff   *Decimal    `protobuf:"bytes,27,opt,name=average_pps_round_diff,json=averagePpsRoundDiff,proto3" json:"average_pps_round_diff,omitempty"`
+	AverageEffort         *Decimal    `protobuf:"bytes,28,opt,name=average_effort,json=averageEffort,proto3" json:"average_effort,omitempty"`
+	AverageLastEffort     *Decimal    `protobuf:"bytes,29,opt,name=average_last_effort,json=averageLastEffort,proto3" json:"average_last_effort,omitempty"`
+	MaxPaymentThreshold   *Decimal    `protobuf:"bytes,30,opt,name=max_payment_threshold,json=maxPaymentThreshold,proto3" json:"max_payment_threshold,omitempty"` // максимальный порог выплаты кошелька (не задан - без ограничения)
+}
+
+func (x *Coin) Reset() {
+	*x = Coin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Coin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coin) ProtoMessage() {}
+
+func (x *Coin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coin.ProtoReflect.Descriptor instead.
+func (*Coin) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Coin) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Coin) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Coin) GetSymbol2() string {
+	if x != nil {
+		return x.Symbol2
+	}
+	return ""
+}
+
+func (x *Coin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Coin) GetAlgo() string {
+	if x != nil {
+		return x.Algo
+	}
+	return ""
+}
+
+func (x *Coin) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Coin) GetTransactionsExplorer() string {
+	if x != nil {
+		return x.TransactionsExplorer
+	}
+	return ""
+}
+
+func (x *Coin) GetBlockExplorer() string {
+	if x != nil {
+		return x.BlockExplorer
+	}
+	return ""
+}
+
+func (x *Coin) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Coin) GetParams() *CoinParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *Coin) GetSeoTitle() string {
+	if x != nil {
+		return x.SeoTitle
+	}
+	return ""
+}
+
+func (x *Coin) GetLastRewardProcessedId() int64 {
+	if x != nil {
+		return x.LastRewardProcessedId
+	}
+	return 0
+}
+
+func (x *Coin) GetMinWithdraw() *Decimal {
+	if x != nil {
+		return x.MinWithdraw
+	}
+	return nil
+}
+
+func (x *Coin) GetAverageRoundDiff() *Decimal {
+	if x != nil {
+		return x.AverageRoundDiff
+	}
+	return nil
+}
+
+func (x *Coin) GetAverageSoloRoundDiff() *Decimal {
+	if x != nil {
+		return x.AverageSoloRoundDiff
+	}
+	return nil
+}
+
+func (x *Coin) GetCurrentEffort() *Decimal {
+	if x != nil {
+		return x.CurrentEffort
+	}
+	return nil
+}
+
+func (x *Coin) GetCoinsInBlock() *Decimal {
+	if x != nil {
+		return x.CoinsInBlock
+	}
+	return nil
+}
+
+func (x *Coin) GetAveragePpsRoundDiff() *Decimal {
+	if x != nil {
+		return x.AveragePpsRoundDiff
+	}
+	return nil
+}
+
+func (x *Coin) GetAverageEffort() *Decimal {
+	if x != nil {
+		return x.AverageEffort
+	}
+	return nil
+}
+
+func (x *Coin) GetAverageLastEffort() *Decimal {
+	if x != nil {
+		return x.AverageLastEffort
+	}
+	return nil
+}
+
+func (x *Coin) GetMaxPaymentThreshold() *Decimal {
+	if x != nil {
+		return x.MaxPaymentThreshold
+	}
+	return nil
+}
+
+// Дополнительные параметры монеты (значения - неотрицательные числа)
+type CoinParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrencyRates                     map[string]*Decimal `protobuf:"bytes,6,rep,name=currency_rates,json=currencyRates,proto3" json:"currency_rates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // курсы монеты (код валюты => курс)
+	CurrentRewardPerGigahash          *Decimal            `protobuf:"bytes,7,opt,name=current_reward_per_gigahash,json=currentRewardPerGigahash,proto3" json:"current_reward_per_gigahash,omitempty"`                                                    // текущая доходность на гигахеш (PPLNS)
+	CurrentRewardPerGigahashSolo      *Decimal            `protobuf:"bytes,8,opt,name=current_reward_per_gigahash_solo,json=currentRewardPerGigahashSolo,proto3" json:"current_reward_per_gigahash_solo,omitempty"`                                      // то же для SOLO
+	CurrentRewardPerGigahashPps       *Decimal            `protobuf:"bytes,9,opt,name=current_reward_per_gigahash_pps,json=currentRewardPerGigahashPps,proto3" json:"current_reward_per_gigahash_pps,omitempty"`                                         // то же для PPS (монет в сутки за 1 GH/s)
+	CurrentRewardPerGigahashMinerstat *Decimal            `protobuf:"bytes,10,opt,name=current_reward_per_gigahash_minerstat,json=currentRewardPerGigahashMinerstat,proto3" json:"current_reward_per_gigahash_minerstat,omitempty"`                      // доходность по данным minerstat
+}
+
+func (x *CoinParams) Reset() {
+	*x = CoinParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoinParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinParams) ProtoMessage() {}
+
+func (x *CoinParams) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinParams.ProtoReflect.Descriptor instead.
+func (*CoinParams) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CoinParams) GetCurrencyRates() map[string]*Decimal {
+	if x != nil {
+		return x.CurrencyRates
+	}
+	return nil
+}
+
+func (x *CoinParams) GetCurrentRewardPerGigahash() *Decimal {
+	if x != nil {
+		return x.CurrentRewardPerGigahash
+	}
+	return nil
+}
+
+func (x *CoinParams) GetCurrentRewardPerGigahashSolo() *Decimal {
+	if x != nil {
+		return x.CurrentRewardPerGigahashSolo
+	}
+	return nil
+}
+
+func (x *CoinParams) GetCurrentRewardPerGigahashPps() *Decimal {
+	if x != nil {
+		return x.CurrentRewardPerGigahashPps
+	}
+	return nil
+}
+
+func (x *CoinParams) GetCurrentRewardPerGigahashMinerstat() *Decimal {
+	if x != nil {
+		return x.CurrentRewardPerGigahashMinerstat
+	}
+	return nil
+}
+
+type ListCoinsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // только активные монеты
+}
+
+func (x *ListCoinsRequest) Reset() {
+	*x = ListCoinsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCoinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoinsRequest) ProtoMessage() {}
+
+func (x *ListCoinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoinsRequest.ProtoReflect.Descriptor instead.
+func (*ListCoinsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListCoinsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListCoinsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coins []*Coin `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty"`
+}
+
+func (x *ListCoinsResponse) Reset() {
+	*x = ListCoinsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCoinsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoinsResponse) ProtoMessage() {}
+
+func (x *ListCoinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoinsResponse.ProtoReflect.Descriptor instead.
+func (*ListCoinsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListCoinsResponse) GetCoins() []*Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+type GetCoinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetCoinRequest) Reset() {
+	*x = GetCoinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinRequest) ProtoMessage() {}
+
+func (x *GetCoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinRequest.ProtoReflect.Descriptor instead.
+func (*GetCoinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetCoinRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCoinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coin *Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+}
+
+func (x *GetCoinResponse) Reset() {
+	*x = GetCoinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinResponse) ProtoMessage() {}
+
+func (x *GetCoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinResponse.ProtoReflect.Descriptor instead.
+func (*GetCoinResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetCoinResponse) GetCoin() *Coin {
+	if x != nil {
+		return x.Coin
+	}
+	return nil
+}
+
+type CreateCoinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coin *Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"` // id игнорируется
+}
+
+func (x *CreateCoinRequest) Reset() {
+	*x = CreateCoinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCoinRequest) ProtoMessage() {}
+
+func (x *CreateCoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCoinRequest.ProtoReflect.Descriptor instead.
+func (*CreateCoinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateCoinRequest) GetCoin() *Coin {
+	if x != nil {
+		return x.Coin
+	}
+	return nil
+}
+
+type CreateCoinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateCoinResponse) Reset() {
+	*x = CreateCoinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCoinResponse) ProtoMessage() {}
+
+func (x *CreateCoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCoinResponse.ProtoReflect.Descriptor instead.
+func (*CreateCoinResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateCoinResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateCoinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coin *Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"` // is_active не изменяется (см. SetCoinActive)
+}
+
+func (x *UpdateCoinRequest) Reset() {
+	*x = UpdateCoinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCoinRequest) ProtoMessage() {}
+
+func (x *UpdateCoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCoinRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCoinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateCoinRequest) GetCoin() *Coin {
+	if x != nil {
+		return x.Coin
+	}
+	return nil
+}
+
+type UpdateCoinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coin *Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+}
+
+func (x *UpdateCoinResponse) Reset() {
+	*x = UpdateCoinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCoinResponse) ProtoMessage() {}
+
+func (x *UpdateCoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCoinResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCoinResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateCoinResponse) GetCoin() *Coin {
+	if x != nil {
+		return x.Coin
+	}
+	return nil
+}
+
+type SetCoinActiveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsActive bool  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+}
+
+func (x *SetCoinActiveRequest) Reset() {
+	*x = SetCoinActiveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCoinActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoinActiveRequest) ProtoMessage() {}
+
+func (x *SetCoinActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoinActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetCoinActiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetCoinActiveRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetCoinActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SetCoinActiveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetCoinActiveResponse) Reset() {
+	*x = SetCoinActiveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCoinActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoinActiveResponse) ProtoMessage() {}
+
+func (x *SetCoinActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoinActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetCoinActiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{55}
+}
+
+type GetCoinParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoinId int64 `protobuf:"varint,1,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+}
+
+func (x *GetCoinParamsRequest) Reset() {
+	*x = GetCoinParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCoinParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinParamsRequest) ProtoMessage() {}
+
+func (x *GetCoinParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinParamsRequest.ProtoReflect.Descriptor instead.
+func (*GetCoinParamsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetCoinParamsRequest) GetCoinId() int64 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+type GetCoinParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params *CoinParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *GetCoinParamsResponse) Reset() {
+	*x = GetCoinParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCoinParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinParamsResponse) ProtoMessage() {}
+
+func (x *GetCoinParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinParamsResponse.ProtoReflect.Descriptor instead.
+func (*GetCoinParamsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetCoinParamsResponse) GetParams() *CoinParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// Изменяются только переданные параметры, курсы валют добавляются/заменяются по коду валюты
+type PatchCoinParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoinId                            int64               `protobuf:"varint,1,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	CurrencyRates                     map[string]*Decimal `protobuf:"bytes,7,rep,name=currency_rates,json=currencyRates,proto3" json:"currency_rates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CurrentRewardPerGigahash          *Decimal            `protobuf:"bytes,8,opt,name=current_reward_per_gigahash,json=currentRewardPerGigahash,proto3" json:"current_reward_per_gigahash,omitempty"` // не задано - не изменяется
+	CurrentRewardPerGigahashSolo      *Decimal            `protobuf:"bytes,9,opt,name=current_reward_per_gigahash_solo,json=currentRewardPerGigahashSolo,proto3" json:"current_reward_per_gigahash_solo,omitempty"`
+	CurrentRewardPerGigahashPps       *Decimal            `protobuf:"bytes,10,opt,name=current_reward_per_gigahash_pps,json=currentRewardPerGigahashPps,proto3" json:"current_reward_per_gigahash_pps,omitempty"`
+	CurrentRewardPerGigahashMinerstat *Decimal            `protobuf:"bytes,11,opt,name=current_reward_per_gigahash_minerstat,json=currentRewardPerGigahashMinerstat,proto3" json:"current_reward_per_gigahash_minerstat,omitempty"`
+}
+
+func (x *PatchCoinParamsRequest) Reset() {
+	*x = PatchCoinParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchCoinParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchCoinParamsRequest) ProtoMessage() {}
+
+func (x *PatchCoinParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchCoinParamsRequest.ProtoReflect.Descriptor instead.
+func (*PatchCoinParamsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PatchCoinParamsRequest) GetCoinId() int64 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+func (x *PatchCoinParamsRequest) GetCurrencyRates() map[string]*Decimal {
+	if x != nil {
+		return x.CurrencyRates
+	}
+	return nil
+}
+
+func (x *PatchCoinParamsRequest) GetCurrentRewardPerGigahash() *Decimal {
+	if x != nil {
+		return x.CurrentRewardPerGigahash
+	}
+	return nil
+}
+
+func (x *PatchCoinParamsRequest) GetCurrentRewardPerGigahashSolo() *Decimal {
+	if x != nil {
+		return x.CurrentRewardPerGigahashSolo
+	}
+	return nil
+}
+
+func (x *PatchCoinParamsRequest) GetCurrentRewardPerGigahashPps() *Decimal {
+	if x != nil {
+		return x.CurrentRewardPerGigahashPps
+	}
+	return nil
+}
+
+func (x *PatchCoinParamsRequest) GetCurrentRewardPerGigahashMinerstat() *Decimal {
+	if x != nil {
+		return x.CurrentRewardPerGigahashMinerstat
+	}
+	return nil
+}
+
+type PatchCoinParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params *CoinParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"` // параметры после изменения
+}
+
+func (x *PatchCoinParamsResponse) Reset() {
+	*x = PatchCoinParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchCoinParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchCoinParamsResponse) ProtoMessage() {}
+
+func (x *PatchCoinParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchCoinParamsResponse.ProtoReflect.Descriptor instead.
+func (*PatchCoinParamsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PatchCoinParamsResponse) GetParams() *CoinParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// Сообщение для деталей ошибки
+type MPError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method      string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`           // метод, где возникла ошибка
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // описание
+}
+
+func (x *MPError) Reset() {
+	*x = MPError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MPError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MPError) ProtoMessage() {}
+
+func (x *MPError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MPError.ProtoReflect.Descriptor instead.
+func (*MPError) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MPError) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *MPError) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DeleteWalletRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWalletRequest) Reset() {
+	*x = DeleteWalletRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWalletRequest) ProtoMessage() {}
+
+func (x *DeleteWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWalletRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWalletRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteWalletRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWalletResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkersDeleted int32 `protobuf:"varint,1,opt,name=workers_deleted,json=workersDeleted,proto3" json:"workers_deleted,omitempty"` // количество удаленных вместе с кошельком воркеров
+}
+
+func (x *DeleteWalletResponse) Reset() {
+	*x = DeleteWalletResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWalletResponse) ProtoMessage() {}
+
+func (x *DeleteWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWalletResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWalletResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteWalletResponse) GetWorkersDeleted() int32 {
+	if x != nil {
+		return x.WorkersDeleted
+	}
+	return 0
+}
+
+type DeleteWorkerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWorkerRequest) Reset() {
+	*x = DeleteWorkerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkerRequest) ProtoMessage() {}
+
+func (x *DeleteWorkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteWorkerRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWorkerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteWorkerResponse) Reset() {
+	*x = DeleteWorkerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkerResponse) ProtoMessage() {}
+
+func (x *DeleteWorkerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{64}
+}
+
+type MergeWorkersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId int64 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"` // удаляемый воркер
+	TargetId int64 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // воркер, в который переносятся история адресов и хешрейт
+}
+
+func (x *MergeWorkersRequest) Reset() {
+	*x = MergeWorkersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeWorkersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeWorkersRequest) ProtoMessage() {}
+
+func (x *MergeWorkersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeWorkersRequest.ProtoReflect.Descriptor instead.
+func (*MergeWorkersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MergeWorkersRequest) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *MergeWorkersRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type MergeWorkersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MergeWorkersResponse) Reset() {
+	*x = MergeWorkersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeWorkersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeWorkersResponse) ProtoMessage() {}
+
+func (x *MergeWorkersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeWorkersResponse.ProtoReflect.Descriptor instead.
+func (*MergeWorkersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{66}
+}
+
+// Десятичное число без потери точности (сложности, награды): строка вида 123.456, масштаб сохраняется
+type Decimal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Decimal) Reset() {
+	*x = Decimal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Decimal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decimal) ProtoMessage() {}
+
+func (x *Decimal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decimal.ProtoReflect.Descriptor instead.
+func (*Decimal) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Decimal) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Шара в том виде, как она приходит с пул-сервера
+type SubmittedShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Miner      *MinerIdentity `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	Uuid       string         `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`                             // уникальный идентификатор шары
+	ShareDate  int64          `protobuf:"varint,3,opt,name=share_date,json=shareDate,proto3" json:"share_date,omitempty"` // unix time в миллисекундах
+	Nonce      string         `protobuf:"bytes,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Difficulty *Decimal       `protobuf:"bytes,7,opt,name=difficulty,proto3" json:"difficulty,omitempty"` // сложность майнера
+	Sharedif   *Decimal       `protobuf:"bytes,8,opt,name=sharedif,proto3" json:"sharedif,omitempty"`     // реальная сложность шары
+}
+
+func (x *SubmittedShare) Reset() {
+	*x = SubmittedShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmittedShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmittedShare) ProtoMessage() {}
+
+func (x *SubmittedShare) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmittedShare.ProtoReflect.Descriptor instead.
+func (*SubmittedShare) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SubmittedShare) GetMiner() *MinerIdentity {
+	if x != nil {
+		return x.Miner
+	}
+	return nil
+}
+
+func (x *SubmittedShare) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SubmittedShare) GetShareDate() int64 {
+	if x != nil {
+		return x.ShareDate
+	}
+	return 0
+}
+
+func (x *SubmittedShare) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *SubmittedShare) GetDifficulty() *Decimal {
+	if x != nil {
+		return x.Difficulty
+	}
+	return nil
+}
+
+func (x *SubmittedShare) GetSharedif() *Decimal {
+	if x != nil {
+		return x.Sharedif
+	}
+	return nil
+}
+
+type SubmitSharesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shares []*SubmittedShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *SubmitSharesRequest) Reset() {
+	*x = SubmitSharesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSharesRequest) ProtoMessage() {}
+
+func (x *SubmitSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSharesRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSharesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SubmitSharesRequest) GetShares() []*SubmittedShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type SubmitSharesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses   []ShareStatus `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=grpc.ShareStatus" json:"statuses,omitempty"` // в порядке запроса
+	Accepted   int32         `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Duplicates int32         `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+}
+
+func (x *SubmitSharesResponse) Reset() {
+	*x = SubmitSharesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSharesResponse) ProtoMessage() {}
+
+func (x *SubmitSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSharesResponse.ProtoReflect.Descriptor instead.
+func (*SubmitSharesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SubmitSharesResponse) GetStatuses() []ShareStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *SubmitSharesResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *SubmitSharesResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+type GetShareDuplicateStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetShareDuplicateStatsRequest) Reset() {
+	*x = GetShareDuplicateStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetShareDuplicateStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareDuplicateStatsRequest) ProtoMessage() {}
+
+func (x *GetShareDuplicateStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareDuplicateStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetShareDuplicateStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{71}
+}
+
+type ShareDuplicateStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId      string  `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Shares        uint64  `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`                                     // проверено шар
+	Duplicates    uint64  `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`                             // из них повторов
+	DuplicateRate float64 `protobuf:"fixed64,4,opt,name=duplicate_rate,json=duplicateRate,proto3" json:"duplicate_rate,omitempty"` // доля повторов
+}
+
+func (x *ShareDuplicateStats) Reset() {
+	*x = ShareDuplicateStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareDuplicateStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareDuplicateStats) ProtoMessage() {}
+
+func (x *ShareDuplicateStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareDuplicateStats.ProtoReflect.Descriptor instead.
+func (*ShareDuplicateStats) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ShareDuplicateStats) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ShareDuplicateStats) GetShares() uint64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *ShareDuplicateStats) GetDuplicates() uint64 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ShareDuplicateStats) GetDuplicateRate() float64 {
+	if x != nil {
+		return x.DuplicateRate
+	}
+	return 0
+}
+
+type GetShareDuplicateStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servers []*ShareDuplicateStats `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"` // по возрастанию server_id
+}
+
+func (x *GetShareDuplicateStatsResponse) Reset() {
+	*x = GetShareDuplicateStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_miners_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetShareDuplicateStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareDuplicateStatsResponse) ProtoMessage() {}
+
+func (x *GetShareDuplicateStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_miners_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareDuplicateStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetShareDuplicateStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_miners_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetShareDuplicateStatsResponse) GetServers() []*ShareDuplicateStats {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+var File_proto_miners_proto protoreflect.FileDescriptor
+
+var file_proto_miners_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x1d, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x69, 0x6e, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x10, 0x20, 0x52, 0x04, 0x63, 0x6f, 0x69,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x29, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x20, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xca, 0xf3, 0x18, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x73, 0x6f, 0x6c, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x69, 0x73, 0x53, 0x6f, 0x6c, 0x6f, 0x12, 0x2b,
+	0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x28, 0x01, 0x52, 0x0c, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xeb, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x07, 0x63,
+	0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xf3,
+	0x18, 0x04, 0x08, 0x01, 0x20, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x29,
+	0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xca, 0xf3, 0x18, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01, 0x52, 0x0a, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x06, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xf3, 0x18, 0x05, 0x08,
+	0x01, 0x10, 0xff, 0x01, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x06,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xf3,
+	0x18, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12,
+	0x25, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x10, 0x20, 0x52, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x10, 0x2d, 0x38, 0x01, 0x52, 0x02, 0x69, 0x70,
+	0x12, 0x1b, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x73, 0x6f, 0x6c, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x69, 0x73, 0x53, 0x6f, 0x6c, 0x6f, 0x12, 0x2b, 0x0a,
+	0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a, 0x25, 0xd2, 0xf3, 0x18, 0x21,
+	0x0a, 0x1f, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x06,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x1a, 0x01,
+	0x2e, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xf3, 0x18, 0x05, 0x08, 0x01, 0x10, 0xff,
+	0x01, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02,
+	0x08, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0d, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xf3, 0x18, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01,
+	0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x1f, 0x0a, 0x07,
+	0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xca,
+	0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a,
+	0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8a, 0x02, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x10,
+	0x20, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xf3, 0x18, 0x05, 0x08, 0x01, 0x10,
+	0xff, 0x01, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x0a, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xca, 0xf3, 0x18, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x08, 0x01,
+	0x10, 0x20, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x10, 0x2d,
+	0x38, 0x01, 0x52, 0x02, 0x69, 0x70, 0x12, 0x2b, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca,
+	0xf3, 0x18, 0x02, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x3a, 0x1f, 0xd2, 0xf3, 0x18, 0x1b, 0x0a, 0x19, 0x0a, 0x0a, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x1a,
+	0x01, 0x2e, 0x20, 0x01, 0x22, 0x43, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x62, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x22, 0x44, 0x0a,
+	0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x20, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e,
+	0x49, 0x64, 0x22, 0x66, 0x0a, 0x10, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x56, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x50, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08,
+	0x01, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x56, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x50, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x50, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x50, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x22, 0xab, 0x03, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18,
+	0x03, 0x10, 0xff, 0x01, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x48, 0x61, 0x73,
+	0x68, 0x72, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x48, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x54, 0x6f, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x6f,
+	0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73,
+	0x63, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x22,
+	0xa5, 0x02, 0x0a, 0x0a, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52,
+	0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x22, 0x2a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x22, 0x41, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x08, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x49, 0x64, 0x22, 0xa3, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52,
+	0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x4d, 0x0a, 0x1b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x19, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x30, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x12, 0x41, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x52, 0x13, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x05, 0x22, 0xaa, 0x01, 0x0a, 0x1a,
+	0x53, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x09, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xca,
+	0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x42, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08,
+	0x01, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03, 0x10, 0xff, 0x01, 0x52, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0xce, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x4d, 0x0a, 0x1b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x19, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4a, 0x04, 0x08,
+	0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x5f, 0x0a, 0x22, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x16, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x2a, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x4a, 0x04, 0x08, 0x01,
+	0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x5d, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18,
+	0x02, 0x08, 0x01, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x42, 0x0a,
+	0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52,
+	0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x1d, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xca, 0xf3, 0x18, 0x03, 0x10, 0xff, 0x01, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x8a, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x3f, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xca, 0xf3, 0x18, 0x05, 0x08, 0x01, 0x10, 0x80, 0x01, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7e, 0x0a, 0x1d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x10, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4a, 0x04,
+	0x08, 0x02, 0x10, 0x03, 0x22, 0x69, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xfb, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x42,
+	0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xca, 0xf3, 0x18, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x28,
+	0x01, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xdc, 0x03,
+	0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30,
+	0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x14, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x72, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x0b, 0x22, 0x71, 0x0a, 0x1b,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xcf, 0x02, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x23, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x30, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44,
+	0x69, 0x66, 0x66, 0x12, 0x30, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x72, 0x61,
+	0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x4a, 0x04, 0x08, 0x06, 0x10,
+	0x07, 0x22, 0x43, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x54, 0x0a,
+	0x18, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03, 0x10, 0xff, 0x01, 0x52, 0x06, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0xec, 0x07, 0x0a, 0x04, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x08,
+	0x01, 0x10, 0x20, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x07, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3,
+	0x18, 0x02, 0x10, 0x20, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x32, 0x12, 0x1b, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18,
+	0x03, 0x10, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x61, 0x6c,
+	0x67, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03, 0x10, 0xff,
+	0x01, 0x52, 0x04, 0x61, 0x6c, 0x67, 0x6f, 0x12, 0x1d, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03, 0x10, 0xff, 0x01, 0x52,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x6f, 0x72, 0x65, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03, 0x10, 0xff, 0x01, 0x52, 0x14,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x78, 0x70, 0x6c,
+	0x6f, 0x72, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78,
+	0x70, 0x6c, 0x6f, 0x72, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3,
+	0x18, 0x03, 0x10, 0xff, 0x01, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x70, 0x6c,
+	0x6f, 0x72, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x28, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x65, 0x6f, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x12, 0x3b, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x10,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x69, 0x66, 0x66,
+	0x12, 0x44, 0x0a, 0x17, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x6f, 0x6c, 0x6f,
+	0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x52, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x6c, 0x6f, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x34, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0d, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x12, 0x33, 0x0a, 0x0e,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x52, 0x0c, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x42, 0x0a, 0x16, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x70, 0x73,
+	0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x52, 0x13, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x70, 0x73, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x34, 0x0a, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x5f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0d, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x13, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x66, 0x66, 0x6f,
+	0x72, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x11, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x4c, 0x61, 0x73, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x12, 0x41, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4a, 0x04, 0x08,
+	0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x0c, 0x10, 0x13, 0x4a, 0x04, 0x08, 0x15, 0x10, 0x16, 0x22,
+	0x8a, 0x04, 0x0a, 0x0a, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4a,
+	0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x1b, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x67, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x18,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72,
+	0x47, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68, 0x12, 0x55, 0x0a, 0x20, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67,
+	0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x6f, 0x6c, 0x6f, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x52, 0x1c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x50, 0x65, 0x72, 0x47, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68, 0x53, 0x6f, 0x6c, 0x6f, 0x12,
+	0x53, 0x0a, 0x1f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x70,
+	0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x47, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73,
+	0x68, 0x50, 0x70, 0x73, 0x12, 0x5f, 0x0a, 0x25, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x69, 0x67, 0x61, 0x68,
+	0x61, 0x73, 0x68, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x74, 0x61, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x52, 0x21, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x50, 0x65, 0x72, 0x47, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x74, 0x61, 0x74, 0x1a, 0x4f, 0x0a, 0x12, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x06, 0x22, 0x33, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c,
+	0x79, 0x22, 0x35, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x31, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52,
+	0x04, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x3b, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x6f,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x04, 0x63, 0x6f,
+	0x69, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52,
+	0x04, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x34, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x63,
+	0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x4b, 0x0a, 0x14, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x37, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02,
+	0x08, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xc3, 0x04,
+	0x0a, 0x16, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08,
+	0x01, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x56, 0x0a, 0x0e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f,
+	0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x4c, 0x0a, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x47, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x55, 0x0a, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x73,
+	0x6f, 0x6c, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x1c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x47, 0x69, 0x67, 0x61, 0x68, 0x61,
+	0x73, 0x68, 0x53, 0x6f, 0x6c, 0x6f, 0x12, 0x53, 0x0a, 0x1f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x69, 0x67,
+	0x61, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x70, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x1b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72,
+	0x47, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68, 0x50, 0x70, 0x73, 0x12, 0x5f, 0x0a, 0x25, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x67, 0x69, 0x67, 0x61, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x74, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x21, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x47, 0x69, 0x67, 0x61, 0x68,
+	0x61, 0x73, 0x68, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x74, 0x61, 0x74, 0x1a, 0x4f, 0x0a, 0x12,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08,
+	0x02, 0x10, 0x07, 0x22, 0x43, 0x0a, 0x17, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x69, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x43, 0x0a, 0x07, 0x4d, 0x50, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3f, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x2d, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x13, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x09, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06,
+	0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x08, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a,
+	0x07, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xf3, 0x18, 0x06, 0x08, 0x01, 0x10,
+	0x50, 0x30, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x0e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x31, 0x0a,
+	0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xca, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x10, 0x40, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xf3, 0x18, 0x05, 0x08, 0x01, 0x10, 0x80, 0x01, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08,
+	0x01, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x31, 0x0a,
+	0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x69, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x06,
+	0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x69, 0x66,
+	0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x43, 0x0a, 0x13,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x55, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x2a, 0xc1, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x50, 0x50, 0x4c, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45,
+	0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x50, 0x50, 0x53, 0x10,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x50, 0x50, 0x53, 0x5f, 0x50, 0x4c, 0x55, 0x53, 0x10, 0x03, 0x12, 0x16, 0x0a,
+	0x12, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x46,
+	0x50, 0x50, 0x53, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x4f, 0x4c, 0x4f, 0x10, 0x05, 0x12, 0x16, 0x0a,
+	0x12, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x50,
+	0x52, 0x4f, 0x50, 0x10, 0x06, 0x2a, 0x9b, 0x01, 0x0a, 0x0f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x41, 0x4c,
+	0x4c, 0x45, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a,
+	0x10, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x52,
+	0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x48, 0x41, 0x53,
+	0x48, 0x52, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x57, 0x41, 0x4c, 0x4c, 0x45,
+	0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41,
+	0x54, 0x10, 0x04, 0x2a, 0x60, 0x0a, 0x12, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x11, 0x57, 0x4f, 0x52,
+	0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x4f,
+	0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x46, 0x46, 0x4c,
+	0x49, 0x4e, 0x45, 0x10, 0x02, 0x2a, 0xad, 0x01, 0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a,
+	0x0a, 0x16, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44,
+	0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48,
+	0x41, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x5f, 0x43, 0x4f, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x2a, 0x0a, 0x26, 0x53, 0x48, 0x41,
+	0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50,
+	0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x10, 0x04, 0x32, 0xa8, 0x13, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x69, 0x6e, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x19,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x44, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x44, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x13, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x54, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x49, 0x50, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x50, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x50,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x18,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x42, 0x79, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x42,
+	0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x54, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x20, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x20, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x15, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
+	0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x69, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
+	0x6e, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x2f, 0x6d, 0x70, 0x6d, 0x2d, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_miners_proto_rawDescOnce sync.Once
+	file_proto_miners_proto_rawDescData = file_proto_miners_proto_rawDesc
+)
+
+func file_proto_miners_proto_rawDescGZIP() []byte {
+	file_proto_miners_proto_rawDescOnce.Do(func() {
+		file_proto_miners_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_miners_proto_rawDescData)
+	})
+	return file_proto_miners_proto_rawDescData
+}
+
+var file_proto_miners_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_miners_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
+var file_proto_miners_proto_goTypes = []interface{}{
+	(RewardMethod)(0),                           // 0: grpc.RewardMethod
+	(WalletSortField)(0),                        // 1: grpc.WalletSortField
+	(WorkerStatusFilter)(0),                     // 2: grpc.WorkerStatusFilter
+	(ShareStatus)(0),                            // 3: grpc.ShareStatus
+	(*GetCoinIDByNameRequest)(nil),              // 4: grpc.GetCoinIDByNameRequest
+	(*GetCoinIDByNameResponse)(nil),             // 5: grpc.GetCoinIDByNameResponse
+	(*CreateWalletRequest)(nil),                 // 6: grpc.CreateWalletRequest
+	(*CreateWalletResponse)(nil),                // 7: grpc.CreateWalletResponse
+	(*CreateWorkerRequest)(nil),                 // 8: grpc.CreateWorkerRequest
+	(*CreateWorkerResponse)(nil),                // 9: grpc.CreateWorkerResponse
+	(*GetWalletIDByNameRequest)(nil),            // 10: grpc.GetWalletIDByNameRequest
+	(*GetWalletIDByNameResponse)(nil),           // 11: grpc.GetWalletIDByNameResponse
+	(*GetWorkerIDByNameRequest)(nil),            // 12: grpc.GetWorkerIDByNameRequest
+	(*GetWorkerIDByNameResponse)(nil),           // 13: grpc.GetWorkerIDByNameResponse
+	(*MinerIdentity)(nil),                       // 14: grpc.MinerIdentity
+	(*ResolveMinersRequest)(nil),                // 15: grpc.ResolveMinersRequest
+	(*ResolvedMiner)(nil),                       // 16: grpc.ResolvedMiner
+	(*ResolveMinersResponse)(nil),               // 17: grpc.ResolveMinersResponse
+	(*ListRewardMethodsRequest)(nil),            // 18: grpc.ListRewardMethodsRequest
+	(*RewardMethodInfo)(nil),                    // 19: grpc.RewardMethodInfo
+	(*ListRewardMethodsResponse)(nil),           // 20: grpc.ListRewardMethodsResponse
+	(*GetWorkerIPHistoryRequest)(nil),           // 21: grpc.GetWorkerIPHistoryRequest
+	(*WorkerIP)(nil),                            // 22: grpc.WorkerIP
+	(*GetWorkerIPHistoryResponse)(nil),          // 23: grpc.GetWorkerIPHistoryResponse
+	(*ListWalletsRequest)(nil),                  // 24: grpc.ListWalletsRequest
+	(*WalletInfo)(nil),                          // 25: grpc.WalletInfo
+	(*GetWalletRequest)(nil),                    // 26: grpc.GetWalletRequest
+	(*GetWalletResponse)(nil),                   // 27: grpc.GetWalletResponse
+	(*GetPaymentThresholdRequest)(nil),          // 28: grpc.GetPaymentThresholdRequest
+	(*GetPaymentThresholdResponse)(nil),         // 29: grpc.GetPaymentThresholdResponse
+	(*SetPaymentThresholdRequest)(nil),          // 30: grpc.SetPaymentThresholdRequest
+	(*SetPaymentThresholdResponse)(nil),         // 31: grpc.SetPaymentThresholdResponse
+	(*ListPaymentThresholdChangesRequest)(nil),  // 32: grpc.ListPaymentThresholdChangesRequest
+	(*PaymentThresholdChange)(nil),              // 33: grpc.PaymentThresholdChange
+	(*ListPaymentThresholdChangesResponse)(nil), // 34: grpc.ListPaymentThresholdChangesResponse
+	(*RequestSettingsChangeRequest)(nil),        // 35: grpc.RequestSettingsChangeRequest
+	(*RequestSettingsChangeResponse)(nil),       // 36: grpc.RequestSettingsChangeResponse
+	(*ConfirmSettingsChangeRequest)(nil),        // 37: grpc.ConfirmSettingsChangeRequest
+	(*ConfirmSettingsChangeResponse)(nil),       // 38: grpc.ConfirmSettingsChangeResponse
+	(*ListWalletsResponse)(nil),                 // 39: grpc.ListWalletsResponse
+	(*ListWorkersByWalletRequest)(nil),          // 40: grpc.ListWorkersByWalletRequest
+	(*WorkerInfo)(nil),                          // 41: grpc.WorkerInfo
+	(*ListWorkersByWalletResponse)(nil),         // 42: grpc.ListWorkersByWalletResponse
+	(*WorkerStats)(nil),                         // 43: grpc.WorkerStats
+	(*UpdateWorkerStatsRequest)(nil),            // 44: grpc.UpdateWorkerStatsRequest
+	(*UpdateWorkerStatsResponse)(nil),           // 45: grpc.UpdateWorkerStatsResponse
+	(*WatchWorkerStatusRequest)(nil),            // 46: grpc.WatchWorkerStatusRequest
+	(*WorkerStatusEvent)(nil),                   // 47: grpc.WorkerStatusEvent
+	(*Coin)(nil),                                // 48: grpc.Coin
+	(*CoinParams)(nil),                          // 49: grpc.CoinParams
+	(*ListCoinsRequest)(nil),                    // 50: grpc.ListCoinsRequest
+	(*ListCoinsResponse)(nil),                   // 51: grpc.ListCoinsResponse
+	(*GetCoinRequest)(nil),                      // 52: grpc.GetCoinRequest
+	(*GetCoinResponse)(nil),                     // 53: grpc.GetCoinResponse
+	(*CreateCoinRequest)(nil),                   // 54: grpc.CreateCoinRequest
+	(*CreateCoinResponse)(nil),                  // 55: grpc.CreateCoinResponse
+	(*UpdateCoinRequest)(nil),                   // 56: grpc.UpdateCoinRequest
+	(*UpdateCoinResponse)(nil),                  // 57: grpc.UpdateCoinResponse
+	(*SetCoinActiveRequest)(nil),                // 58: grpc.SetCoinActiveRequest
+	(*SetCoinActiveResponse)(nil),               // 59: grpc.SetCoinActiveResponse
+	(*GetCoinParamsRequest)(nil),                // 60: grpc.GetCoinParamsRequest
+	(*GetCoinParamsResponse)(nil),               // 61: grpc.GetCoinParamsResponse
+	(*PatchCoinParamsRequest)(nil),              // 62: grpc.PatchCoinParamsRequest
+	(*PatchCoinParamsResponse)(nil),             // 63: grpc.PatchCoinParamsResponse
+	(*MPError)(nil),                             // 64: grpc.MPError
+	(*DeleteWalletRequest)(nil),                 // 65: grpc.DeleteWalletRequest
+	(*DeleteWalletResponse)(nil),                // 66: grpc.DeleteWalletResponse
+	(*DeleteWorkerRequest)(nil),                 // 67: grpc.DeleteWorkerRequest
+	(*DeleteWorkerResponse)(nil),                // 68: grpc.DeleteWorkerResponse
+	(*MergeWorkersRequest)(nil),                 // 69: grpc.MergeWorkersRequest
+	(*MergeWorkersResponse)(nil),                // 70: grpc.MergeWorkersResponse
+	(*Decimal)(nil),                             // 71: grpc.Decimal
+	(*SubmittedShare)(nil),                      // 72: grpc.SubmittedShare
+	(*SubmitSharesRequest)(nil),                 // 73: grpc.SubmitSharesRequest
+	(*SubmitSharesResponse)(nil),                // 74: grpc.SubmitSharesResponse
+	(*GetShareDuplicateStatsRequest)(nil),       // 75: grpc.GetShareDuplicateStatsRequest
+	(*ShareDuplicateStats)(nil),                 // 76: grpc.ShareDuplicateStats
+	(*GetShareDuplicateStatsResponse)(nil),      // 77: grpc.GetShareDuplicateStatsResponse
+	nil,                                         // 78: grpc.CoinParams.CurrencyRatesEntry
+	nil,                                         // 79: grpc.PatchCoinParamsRequest.CurrencyRatesEntry
+}
+var file_proto_miners_proto_depIdxs = []int32{
+	14, // 0: grpc.ResolveMinersRequest.miners:type_name -> grpc.MinerIdentity
+	16, // 1: grpc.ResolveMinersResponse.miners:type_name -> grpc.ResolvedMiner
+	0,  // 2: grpc.RewardMethodInfo.method:type_name -> grpc.RewardMethod
+	19, // 3: grpc.ListRewardMethodsResponse.methods:type_name -> grpc.RewardMethodInfo
+	22, // 4: grpc.GetWorkerIPHistoryResponse.history:type_name -> grpc.WorkerIP
+	1,  // 5: grpc.ListWalletsRequest.sort:type_name -> grpc.WalletSortField
+	71, // 6: grpc.WalletInfo.payment_threshold:type_name -> grpc.Decimal
+	25, // 7: grpc.GetWalletResponse.wallet:type_name -> grpc.WalletInfo
+	71, // 8: grpc.GetPaymentThresholdResponse.payment_threshold:type_name -> grpc.Decimal
+	71, // 9: grpc.GetPaymentThresholdResponse.effective_payment_threshold:type_name -> grpc.Decimal
+	71, // 10: grpc.GetPaymentThresholdResponse.min_withdraw:type_name -> grpc.Decimal
+	71, // 11: grpc.GetPaymentThresholdResponse.max_payment_threshold:type_name -> grpc.Decimal
+	71, // 12: grpc.SetPaymentThresholdRequest.payment_threshold:type_name -> grpc.Decimal
+	71, // 13: grpc.SetPaymentThresholdResponse.payment_threshold:type_name -> grpc.Decimal
+	71, // 14: grpc.SetPaymentThresholdResponse.effective_payment_threshold:type_name -> grpc.Decimal
+	71, // 15: grpc.PaymentThresholdChange.old_value:type_name -> grpc.Decimal
+	71, // 16: grpc.PaymentThresholdChange.new_value:type_name -> grpc.Decimal
+	33, // 17: grpc.ListPaymentThresholdChangesResponse.changes:type_name -> grpc.PaymentThresholdChange
+	71, // 18: grpc.RequestSettingsChangeRequest.payment_threshold:type_name -> grpc.Decimal
+	71, // 19: grpc.ConfirmSettingsChangeResponse.payment_threshold:type_name -> grpc.Decimal
+	25, // 20: grpc.ListWalletsResponse.wallets:type_name -> grpc.WalletInfo
+	2,  // 21: grpc.ListWorkersByWalletRequest.status:type_name -> grpc.WorkerStatusFilter
+	71, // 22: grpc.WorkerInfo.current_diff:type_name -> grpc.Decimal
+	41, // 23: grpc.ListWorkersByWalletResponse.workers:type_name -> grpc.WorkerInfo
+	71, // 24: grpc.WorkerStats.current_diff:type_name -> grpc.Decimal
+	43, // 25: grpc.UpdateWorkerStatsRequest.stats:type_name -> grpc.WorkerStats
+	49, // 26: grpc.Coin.params:type_name -> grpc.CoinParams
+	71, // 27: grpc.Coin.min_withdraw:type_name -> grpc.Decimal
+	71, // 28: grpc.Coin.average_round_diff:type_name -> grpc.Decimal
+	71, // 29: grpc.Coin.average_solo_round_diff:type_name -> grpc.Decimal
+	71, // 30: grpc.Coin.current_effort:type_name -> grpc.Decimal
+	71, // 31: grpc.Coin.coins_in_block:type_name -> grpc.Decimal
+	71, // 32: grpc.Coin.average_pps_round_diff:type_name -> grpc.Decimal
+	71, // 33: grpc.Coin.average_effort:type_name -> grpc.Decimal
+	71, // 34: grpc.Coin.average_last_effort:type_name -> grpc.Decimal
+	71, // 35: grpc.Coin.max_payment_threshold:type_name -> grpc.Decimal
+	78, // 36: grpc.CoinParams.currency_rates:type_name -> grpc.CoinParams.CurrencyRatesEntry
+	71, // 37: grpc.CoinParams.current_reward_per_gigahash:type_name -> grpc.Decimal
+	71, // 38: grpc.CoinParams.current_reward_per_gigahash_solo:type_name -> grpc.Decimal
+	71, // 39: grpc.CoinParams.current_reward_per_gigahash_pps:type_name -> grpc.Decimal
+	71, // 40: grpc.CoinParams.current_reward_per_gigahash_minerstat:type_name -> grpc.Decimal
+	48, // 41: grpc.ListCoinsResponse.coins:type_name -> grpc.Coin
+	48, // 42: grpc.GetCoinResponse.coin:type_name -> grpc.Coin
+	48, // 43: grpc.CreateCoinRequest.coin:type_name -> grpc.Coin
+	48, // 44: grpc.UpdateCoinRequest.coin:type_name -> grpc.Coin
+	48, // 45: grpc.UpdateCoinResponse.coin:type_name -> grpc.Coin
+	49, // 46: grpc.GetCoinParamsResponse.params:type_name -> grpc.CoinParams
+	79, // 47: grpc.PatchCoinParamsRequest.currency_rates:type_name -> grpc.PatchCoinParamsRequest.CurrencyRatesEntry
+	71, // 48: grpc.PatchCoinParamsRequest.current_reward_per_gigahash:type_name -> grpc.Decimal
+	71, // 49: grpc.PatchCoinParamsRequest.current_reward_per_gigahash_solo:type_name -> grpc.Decimal
+	71, // 50: grpc.PatchCoinParamsRequest.current_reward_per_gigahash_pps:type_name -> grpc.Decimal
+	71, // 51: grpc.PatchCoinParamsRequest.current_reward_per_gigahash_minerstat:type_name -> grpc.Decimal
+	49, // 52: grpc.PatchCoinParamsResponse.params:type_name -> grpc.CoinParams
+	14, // 53: grpc.SubmittedShare.miner:type_name -> grpc.MinerIdentity
+	71, // 54: grpc.SubmittedShare.difficulty:type_name -> grpc.Decimal
+	71, // 55: grpc.SubmittedShare.sharedif:type_name -> grpc.Decimal
+	72, // 56: grpc.SubmitSharesRequest.shares:type_name -> grpc.SubmittedShare
+	3,  // 57: grpc.SubmitSharesResponse.statuses:type_name -> grpc.ShareStatus
+	76, // 58: grpc.GetShareDuplicateStatsResponse.servers:type_name -> grpc.ShareDuplicateStats
+	71, // 59: grpc.CoinParams.CurrencyRatesEntry.value:type_name -> grpc.Decimal
+	71, // 60: grpc.PatchCoinParamsRequest.CurrencyRatesEntry.value:type_name -> grpc.Decimal
+	4,  // 61: grpc.MinersService.GetCoinIDByName:input_type -> grpc.GetCoinIDByNameRequest
+	6,  // 62: grpc.MinersService.CreateWallet:input_type -> grpc.CreateWalletRequest
+	8,  // 63: grpc.MinersService.CreateWorker:input_type -> grpc.CreateWorkerRequest
+	10, // 64: grpc.MinersService.GetWalletIDByName:input_type -> grpc.GetWalletIDByNameRequest
+	12, // 65: grpc.MinersService.GetWorkerIDByName:input_type -> grpc.GetWorkerIDByNameRequest
+	15, // 66: grpc.MinersService.ResolveMiners:input_type -> grpc.ResolveMinersRequest
+	14, // 67: grpc.MinersService.StreamResolveMiners:input_type -> grpc.MinerIdentity
+	18, // 68: grpc.MinersService.ListRewardMethods:input_type -> grpc.ListRewardMethodsRequest
+	21, // 69: grpc.MinersService.GetWorkerIPHistory:input_type -> grpc.GetWorkerIPHistoryRequest
+	24, // 70: grpc.MinersService.ListWallets:input_type -> grpc.ListWalletsRequest
+	40, // 71: grpc.MinersService.ListWorkersByWallet:input_type -> grpc.ListWorkersByWalletRequest
+	44, // 72: grpc.MinersService.UpdateWorkerStats:input_type -> grpc.UpdateWorkerStatsRequest
+	46, // 73: grpc.MinersService.WatchWorkerStatus:input_type -> grpc.WatchWorkerStatusRequest
+	26, // 74: grpc.MinersService.GetWallet:input_type -> grpc.GetWalletRequest
+	73, // 75: grpc.MinersService.SubmitShares:input_type -> grpc.SubmitSharesRequest
+	75, // 76: grpc.MinersService.GetShareDuplicateStats:input_type -> grpc.GetShareDuplicateStatsRequest
+	28, // 77: grpc.MinersService.GetPaymentThreshold:input_type -> grpc.GetPaymentThresholdRequest
+	30, // 78: grpc.MinersService.SetPaymentThreshold:input_type -> grpc.SetPaymentThresholdRequest
+	32, // 79: grpc.MinersService.ListPaymentThresholdChanges:input_type -> grpc.ListPaymentThresholdChangesRequest
+	35, // 80: grpc.MinersService.RequestSettingsChange:input_type -> grpc.RequestSettingsChangeRequest
+	37, // 81: grpc.MinersService.ConfirmSettingsChange:input_type -> grpc.ConfirmSettingsChangeRequest
+	50, // 82: grpc.MinersService.ListCoins:input_type -> grpc.ListCoinsRequest
+	52, // 83: grpc.MinersService.GetCoin:input_type -> grpc.GetCoinRequest
+	54, // 84: grpc.MinersService.CreateCoin:input_type -> grpc.CreateCoinRequest
+	56, // 85: grpc.MinersService.UpdateCoin:input_type -> grpc.UpdateCoinRequest
+	58, // 86: grpc.MinersService.SetCoinActive:input_type -> grpc.SetCoinActiveRequest
+	60, // 87: grpc.MinersService.GetCoinParams:input_type -> grpc.GetCoinParamsRequest
+	62, // 88: grpc.MinersService.PatchCoinParams:input_type -> grpc.PatchCoinParamsRequest
+	65, // 89: grpc.MinersService.DeleteWallet:input_type -> grpc.DeleteWalletRequest
+	67, // 90: grpc.MinersService.DeleteWorker:input_type -> grpc.DeleteWorkerRequest
+	69, // 91: grpc.MinersService.MergeWorkers:input_type -> grpc.MergeWorkersRequest
+	5,  // 92: grpc.MinersService.GetCoinIDByName:output_type -> grpc.GetCoinIDByNameResponse
+	7,  // 93: grpc.MinersService.CreateWallet:output_type -> grpc.CreateWalletResponse
+	9,  // 94: grpc.MinersService.CreateWorker:output_type -> grpc.CreateWorkerResponse
+	11, // 95: grpc.MinersService.GetWalletIDByName:output_type -> grpc.GetWalletIDByNameResponse
+	13, // 96: grpc.MinersService.GetWorkerIDByName:output_type -> grpc.GetWorkerIDByNameResponse
+	17, // 97: grpc.MinersService.ResolveMiners:output_type -> grpc.ResolveMinersResponse
+	16, // 98: grpc.MinersService.StreamResolveMiners:output_type -> grpc.ResolvedMiner
+	20, // 99: grpc.MinersService.ListRewardMethods:output_type -> grpc.ListRewardMethodsResponse
+	23, // 100: grpc.MinersService.GetWorkerIPHistory:output_type -> grpc.GetWorkerIPHistoryResponse
+	39, // 101: grpc.MinersService.ListWallets:output_type -> grpc.ListWalletsResponse
+	42, // 102: grpc.MinersService.ListWorkersByWallet:output_type -> grpc.ListWorkersByWalletResponse
+	45, // 103: grpc.MinersService.UpdateWorkerStats:output_type -> grpc.UpdateWorkerStatsResponse
+	47, // 104: grpc.MinersService.WatchWorkerStatus:output_type -> grpc.WorkerStatusEvent
+	27, // 105: grpc.MinersService.GetWallet:output_type -> grpc.GetWalletResponse
+	74, // 106: grpc.MinersService.SubmitShares:output_type -> grpc.SubmitSharesResponse
+	77, // 107: grpc.MinersService.GetShareDuplicateStats:output_type -> grpc.GetShareDuplicateStatsResponse
+	29, // 108: grpc.MinersService.GetPaymentThreshold:output_type -> grpc.GetPaymentThresholdResponse
+	31, // 109: grpc.MinersService.SetPaymentThreshold:output_type -> grpc.SetPaymentThresholdResponse
+	34, // 110: grpc.MinersService.ListPaymentThresholdChanges:output_type -> grpc.ListPaymentThresholdChangesResponse
+	36, // 111: grpc.MinersService.RequestSettingsChange:output_type -> grpc.RequestSettingsChangeResponse
+	38, // 112: grpc.MinersService.ConfirmSettingsChange:output_type -> grpc.ConfirmSettingsChangeResponse
+	51, // 113: grpc.MinersService.ListCoins:output_type -> grpc.ListCoinsResponse
+	53, // 114: grpc.MinersService.GetCoin:output_type -> grpc.GetCoinResponse
+	55, // 115: grpc.MinersService.CreateCoin:output_type -> grpc.CreateCoinResponse
+	57, // 116: grpc.MinersService.UpdateCoin:output_type -> grpc.UpdateCoinResponse
+	59, // 117: grpc.MinersService.SetCoinActive:output_type -> grpc.SetCoinActiveResponse
+	61, // 118: grpc.MinersService.GetCoinParams:output_type -> grpc.GetCoinParamsResponse
+	63, // 119: grpc.MinersService.PatchCoinParams:output_type -> grpc.PatchCoinParamsResponse
+	66, // 120: grpc.MinersService.DeleteWallet:output_type -> grpc.DeleteWalletResponse
+	68, // 121: grpc.MinersService.DeleteWorker:output_type -> grpc.DeleteWorkerResponse
+	70, // 122: grpc.MinersService.MergeWorkers:output_type -> grpc.MergeWorkersResponse
+	92, // [92:123] is the sub-list for method output_type
+	61, // [61:92] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
+}
+
+func init() { file_proto_miners_proto_init() }
+func file_proto_miners_proto_init() {
+	if File_proto_miners_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_miners_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCoinIDByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCoinIDByNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletIDByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletIDByNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkerIDByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkerIDByNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveMinersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolvedMiner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveMinersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRewardMethodsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardMethodInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRewardMethodsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkerIPHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerIP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkerIPHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWalletsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPaymentThresholdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPaymentThresholdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPaymentThresholdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPaymentThresholdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentThresholdChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentThresholdChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentThresholdChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestSettingsChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestSettingsChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmSettingsChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmSettingsChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWalletsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkersByWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkersByWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkerStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkerStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchWorkerStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerStatusEvent); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -879,8 +6431,8 @@ func file_proto_miners_proto_init() {
 				return nil
 			}
 		}
-		file_proto_miners_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWorkerResponse); i {
+		file_proto_miners_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -891,8 +6443,8 @@ func file_proto_miners_proto_init() {
 				return nil
 			}
 		}
-		file_proto_miners_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletIDByNameRequest); i {
+		file_proto_miners_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoinParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -903,8 +6455,8 @@ func file_proto_miners_proto_init() {
 				return nil
 			}
 		}
-		file_proto_miners_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletIDByNameResponse); i {
+		file_proto_miners_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCoinsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -915,8 +6467,8 @@ func file_proto_miners_proto_init() {
 				return nil
 			}
 		}
-		file_proto_miners_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkerIDByNameRequest); i {
+		file_proto_miners_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCoinsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -927,8 +6479,8 @@ func file_proto_miners_proto_init() {
 				return nil
 			}
 		}
-		file_proto_miners_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkerIDByNameResponse); i {
+		file_proto_miners_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCoinRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -939,7 +6491,139 @@ func file_proto_miners_proto_init() {
 				return nil
 			}
 		}
-		file_proto_miners_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_miners_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCoinActiveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCoinActiveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCoinParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCoinParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchCoinParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchCoinParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MPError); i {
 			case 0:
 				return &v.state
@@ -951,19 +6635,178 @@ func file_proto_miners_proto_init() {
 				return nil
 			}
 		}
+		file_proto_miners_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeWorkersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeWorkersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Decimal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmittedShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitSharesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitSharesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetShareDuplicateStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareDuplicateStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_miners_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetShareDuplicateStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_proto_miners_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_proto_miners_proto_msgTypes[39].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_miners_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      4,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_miners_proto_goTypes,
 		DependencyIndexes: file_proto_miners_proto_depIdxs,
+		EnumInfos:         file_proto_miners_proto_enumTypes,
 		MessageInfos:      file_proto_miners_proto_msgTypes,
 	}.Build()
 	File_proto_miners_proto = out.File
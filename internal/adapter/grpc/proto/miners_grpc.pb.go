@@ -19,11 +19,37 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	MinersService_GetCoinIDByName_FullMethodName   = "/grpc.MinersService/GetCoinIDByName"
-	MinersService_CreateWallet_FullMethodName      = "/grpc.MinersService/CreateWallet"
-	MinersService_CreateWorker_FullMethodName      = "/grpc.MinersService/CreateWorker"
-	MinersService_GetWalletIDByName_FullMethodName = "/grpc.MinersService/GetWalletIDByName"
-	MinersService_GetWorkerIDByName_FullMethodName = "/grpc.MinersService/GetWorkerIDByName"
+	MinersService_GetCoinIDByName_FullMethodName             = "/grpc.MinersService/GetCoinIDByName"
+	MinersService_CreateWallet_FullMethodName                = "/grpc.MinersService/CreateWallet"
+	MinersService_CreateWorker_FullMethodName                = "/grpc.MinersService/CreateWorker"
+	MinersService_GetWalletIDByName_FullMethodName           = "/grpc.MinersService/GetWalletIDByName"
+	MinersService_GetWorkerIDByName_FullMethodName           = "/grpc.MinersService/GetWorkerIDByName"
+	MinersService_ResolveMiners_FullMethodName               = "/grpc.MinersService/ResolveMiners"
+	MinersService_StreamResolveMiners_FullMethodName         = "/grpc.MinersService/StreamResolveMiners"
+	MinersService_ListRewardMethods_FullMethodName           = "/grpc.MinersService/ListRewardMethods"
+	MinersService_GetWorkerIPHistory_FullMethodName          = "/grpc.MinersService/GetWorkerIPHistory"
+	MinersService_ListWallets_FullMethodName                 = "/grpc.MinersService/ListWallets"
+	MinersService_ListWorkersByWallet_FullMethodName         = "/grpc.MinersService/ListWorkersByWallet"
+	MinersService_UpdateWorkerStats_FullMethodName           = "/grpc.MinersService/UpdateWorkerStats"
+	MinersService_WatchWorkerStatus_FullMethodName           = "/grpc.MinersService/WatchWorkerStatus"
+	MinersService_GetWallet_FullMethodName                   = "/grpc.MinersService/GetWallet"
+	MinersService_SubmitShares_FullMethodName                = "/grpc.MinersService/SubmitShares"
+	MinersService_GetShareDuplicateStats_FullMethodName      = "/grpc.MinersService/GetShareDuplicateStats"
+	MinersService_GetPaymentThreshold_FullMethodName         = "/grpc.MinersService/GetPaymentThreshold"
+	MinersService_SetPaymentThreshold_FullMethodName         = "/grpc.MinersService/SetPaymentThreshold"
+	MinersService_ListPaymentThresholdChanges_FullMethodName = "/grpc.MinersService/ListPaymentThresholdChanges"
+	MinersService_RequestSettingsChange_FullMethodName       = "/grpc.MinersService/RequestSettingsChange"
+	MinersService_ConfirmSettingsChange_FullMethodName       = "/grpc.MinersService/ConfirmSettingsChange"
+	MinersService_ListCoins_FullMethodName                   = "/grpc.MinersService/ListCoins"
+	MinersService_GetCoin_FullMethodName                     = "/grpc.MinersService/GetCoin"
+	MinersService_CreateCoin_FullMethodName                  = "/grpc.MinersService/CreateCoin"
+	MinersService_UpdateCoin_FullMethodName                  = "/grpc.MinersService/UpdateCoin"
+	MinersService_SetCoinActive_FullMethodName               = "/grpc.MinersService/SetCoinActive"
+	MinersService_GetCoinParams_FullMethodName               = "/grpc.MinersService/GetCoinParams"
+	MinersService_PatchCoinParams_FullMethodName             = "/grpc.MinersService/PatchCoinParams"
+	MinersService_DeleteWallet_FullMethodName                = "/grpc.MinersService/DeleteWallet"
+	MinersService_DeleteWorker_FullMethodName                = "/grpc.MinersService/DeleteWorker"
+	MinersService_MergeWorkers_FullMethodName                = "/grpc.MinersService/MergeWorkers"
 )
 
 // MinersServiceClient is the client API for MinersService service.
@@ -35,6 +61,39 @@ type MinersServiceClient interface {
 	CreateWorker(ctx context.Context, in *CreateWorkerRequest, opts ...grpc.CallOption) (*CreateWorkerResponse, error)
 	GetWalletIDByName(ctx context.Context, in *GetWalletIDByNameRequest, opts ...grpc.CallOption) (*GetWalletIDByNameResponse, error)
 	GetWorkerIDByName(ctx context.Context, in *GetWorkerIDByNameRequest, opts ...grpc.CallOption) (*GetWorkerIDByNameResponse, error)
+	ResolveMiners(ctx context.Context, in *ResolveMinersRequest, opts ...grpc.CallOption) (*ResolveMinersResponse, error)
+	StreamResolveMiners(ctx context.Context, opts ...grpc.CallOption) (MinersService_StreamResolveMinersClient, error)
+	ListRewardMethods(ctx context.Context, in *ListRewardMethodsRequest, opts ...grpc.CallOption) (*ListRewardMethodsResponse, error)
+	GetWorkerIPHistory(ctx context.Context, in *GetWorkerIPHistoryRequest, opts ...grpc.CallOption) (*GetWorkerIPHistoryResponse, error)
+	ListWallets(ctx context.Context, in *ListWalletsRequest, opts ...grpc.CallOption) (*ListWalletsResponse, error)
+	ListWorkersByWallet(ctx context.Context, in *ListWorkersByWalletRequest, opts ...grpc.CallOption) (*ListWorkersByWalletResponse, error)
+	UpdateWorkerStats(ctx context.Context, in *UpdateWorkerStatsRequest, opts ...grpc.CallOption) (*UpdateWorkerStatsResponse, error)
+	WatchWorkerStatus(ctx context.Context, in *WatchWorkerStatusRequest, opts ...grpc.CallOption) (MinersService_WatchWorkerStatusClient, error)
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
+	SubmitShares(ctx context.Context, in *SubmitSharesRequest, opts ...grpc.CallOption) (*SubmitSharesResponse, error)
+	GetShareDuplicateStats(ctx context.Context, in *GetShareDuplicateStatsRequest, opts ...grpc.CallOption) (*GetShareDuplicateStatsResponse, error)
+	// Порог выплаты кошелька (проверяется по min_withdraw и max_payment_threshold монеты, изменения пишутся в журнал)
+	GetPaymentThreshold(ctx context.Context, in *GetPaymentThresholdRequest, opts ...grpc.CallOption) (*GetPaymentThresholdResponse, error)
+	SetPaymentThreshold(ctx context.Context, in *SetPaymentThresholdRequest, opts ...grpc.CallOption) (*SetPaymentThresholdResponse, error)
+	ListPaymentThresholdChanges(ctx context.Context, in *ListPaymentThresholdChangesRequest, opts ...grpc.CallOption) (*ListPaymentThresholdChangesResponse, error)
+	// Изменение настроек по запросу майнера: запрос возвращает одноразовый токен, изменение применяется только после подтверждения токеном.
+	// RequestSettingsChange доступен только сервисам доставки токена (jwt_relay_services): они передают токен майнеру
+	// по подтвержденному каналу (почта, 2FA) и не возвращают его инициатору запроса
+	RequestSettingsChange(ctx context.Context, in *RequestSettingsChangeRequest, opts ...grpc.CallOption) (*RequestSettingsChangeResponse, error)
+	ConfirmSettingsChange(ctx context.Context, in *ConfirmSettingsChangeRequest, opts ...grpc.CallOption) (*ConfirmSettingsChangeResponse, error)
+	// Справочник монет (изменение - только для административных сервисов)
+	ListCoins(ctx context.Context, in *ListCoinsRequest, opts ...grpc.CallOption) (*ListCoinsResponse, error)
+	GetCoin(ctx context.Context, in *GetCoinRequest, opts ...grpc.CallOption) (*GetCoinResponse, error)
+	CreateCoin(ctx context.Context, in *CreateCoinRequest, opts ...grpc.CallOption) (*CreateCoinResponse, error)
+	UpdateCoin(ctx context.Context, in *UpdateCoinRequest, opts ...grpc.CallOption) (*UpdateCoinResponse, error)
+	SetCoinActive(ctx context.Context, in *SetCoinActiveRequest, opts ...grpc.CallOption) (*SetCoinActiveResponse, error)
+	GetCoinParams(ctx context.Context, in *GetCoinParamsRequest, opts ...grpc.CallOption) (*GetCoinParamsResponse, error)
+	PatchCoinParams(ctx context.Context, in *PatchCoinParamsRequest, opts ...grpc.CallOption) (*PatchCoinParamsResponse, error)
+	// Мягкое удаление и объединение кошельков и воркеров (только для административных сервисов)
+	// удаленные записи не находятся по имени и ID, при повторном подключении создается новая запись
+	DeleteWallet(ctx context.Context, in *DeleteWalletRequest, opts ...grpc.CallOption) (*DeleteWalletResponse, error)
+	DeleteWorker(ctx context.Context, in *DeleteWorkerRequest, opts ...grpc.CallOption) (*DeleteWorkerResponse, error)
+	MergeWorkers(ctx context.Context, in *MergeWorkersRequest, opts ...grpc.CallOption) (*MergeWorkersResponse, error)
 }
 
 type minersServiceClient struct {
@@ -90,6 +149,285 @@ func (c *minersServiceClient) GetWorkerIDByName(ctx context.Context, in *GetWork
 	return out, nil
 }
 
+func (c *minersServiceClient) ResolveMiners(ctx context.Context, in *ResolveMinersRequest, opts ...grpc.CallOption) (*ResolveMinersResponse, error) {
+	out := new(ResolveMinersResponse)
+	err := c.cc.Invoke(ctx, MinersService_ResolveMiners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) StreamResolveMiners(ctx context.Context, opts ...grpc.CallOption) (MinersService_StreamResolveMinersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MinersService_ServiceDesc.Streams[0], MinersService_StreamResolveMiners_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &minersServiceStreamResolveMinersClient{stream}
+	return x, nil
+}
+
+type MinersService_StreamResolveMinersClient interface {
+	Send(*MinerIdentity) error
+	Recv() (*ResolvedMiner, error)
+	grpc.ClientStream
+}
+
+type minersServiceStreamResolveMinersClient struct {
+	grpc.ClientStream
+}
+
+func (x *minersServiceStreamResolveMinersClient) Send(m *MinerIdentity) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *minersServiceStreamResolveMinersClient) Recv() (*ResolvedMiner, error) {
+	m := new(ResolvedMiner)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *minersServiceClient) ListRewardMethods(ctx context.Context, in *ListRewardMethodsRequest, opts ...grpc.CallOption) (*ListRewardMethodsResponse, error) {
+	out := new(ListRewardMethodsResponse)
+	err := c.cc.Invoke(ctx, MinersService_ListRewardMethods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) GetWorkerIPHistory(ctx context.Context, in *GetWorkerIPHistoryRequest, opts ...grpc.CallOption) (*GetWorkerIPHistoryResponse, error) {
+	out := new(GetWorkerIPHistoryResponse)
+	err := c.cc.Invoke(ctx, MinersService_GetWorkerIPHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) ListWallets(ctx context.Context, in *ListWalletsRequest, opts ...grpc.CallOption) (*ListWalletsResponse, error) {
+	out := new(ListWalletsResponse)
+	err := c.cc.Invoke(ctx, MinersService_ListWallets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) ListWorkersByWallet(ctx context.Context, in *ListWorkersByWalletRequest, opts ...grpc.CallOption) (*ListWorkersByWalletResponse, error) {
+	out := new(ListWorkersByWalletResponse)
+	err := c.cc.Invoke(ctx, MinersService_ListWorkersByWallet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) UpdateWorkerStats(ctx context.Context, in *UpdateWorkerStatsRequest, opts ...grpc.CallOption) (*UpdateWorkerStatsResponse, error) {
+	out := new(UpdateWorkerStatsResponse)
+	err := c.cc.Invoke(ctx, MinersService_UpdateWorkerStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) WatchWorkerStatus(ctx context.Context, in *WatchWorkerStatusRequest, opts ...grpc.CallOption) (MinersService_WatchWorkerStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MinersService_ServiceDesc.Streams[1], MinersService_WatchWorkerStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &minersServiceWatchWorkerStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MinersService_WatchWorkerStatusClient interface {
+	Recv() (*WorkerStatusEvent, error)
+	grpc.ClientStream
+}
+
+type minersServiceWatchWorkerStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *minersServiceWatchWorkerStatusClient) Recv() (*WorkerStatusEvent, error) {
+	m := new(WorkerStatusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *minersServiceClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
+	out := new(GetWalletResponse)
+	err := c.cc.Invoke(ctx, MinersService_GetWallet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) SubmitShares(ctx context.Context, in *SubmitSharesRequest, opts ...grpc.CallOption) (*SubmitSharesResponse, error) {
+	out := new(SubmitSharesResponse)
+	err := c.cc.Invoke(ctx, MinersService_SubmitShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) GetShareDuplicateStats(ctx context.Context, in *GetShareDuplicateStatsRequest, opts ...grpc.CallOption) (*GetShareDuplicateStatsResponse, error) {
+	out := new(GetShareDuplicateStatsResponse)
+	err := c.cc.Invoke(ctx, MinersService_GetShareDuplicateStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) GetPaymentThreshold(ctx context.Context, in *GetPaymentThresholdRequest, opts ...grpc.CallOption) (*GetPaymentThresholdResponse, error) {
+	out := new(GetPaymentThresholdResponse)
+	err := c.cc.Invoke(ctx, MinersService_GetPaymentThreshold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) SetPaymentThreshold(ctx context.Context, in *SetPaymentThresholdRequest, opts ...grpc.CallOption) (*SetPaymentThresholdResponse, error) {
+	out := new(SetPaymentThresholdResponse)
+	err := c.cc.Invoke(ctx, MinersService_SetPaymentThreshold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) ListPaymentThresholdChanges(ctx context.Context, in *ListPaymentThresholdChangesRequest, opts ...grpc.CallOption) (*ListPaymentThresholdChangesResponse, error) {
+	out := new(ListPaymentThresholdChangesResponse)
+	err := c.cc.Invoke(ctx, MinersService_ListPaymentThresholdChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) RequestSettingsChange(ctx context.Context, in *RequestSettingsChangeRequest, opts ...grpc.CallOption) (*RequestSettingsChangeResponse, error) {
+	out := new(RequestSettingsChangeResponse)
+	err := c.cc.Invoke(ctx, MinersService_RequestSettingsChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) ConfirmSettingsChange(ctx context.Context, in *ConfirmSettingsChangeRequest, opts ...grpc.CallOption) (*ConfirmSettingsChangeResponse, error) {
+	out := new(ConfirmSettingsChangeResponse)
+	err := c.cc.Invoke(ctx, MinersService_ConfirmSettingsChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) ListCoins(ctx context.Context, in *ListCoinsRequest, opts ...grpc.CallOption) (*ListCoinsResponse, error) {
+	out := new(ListCoinsResponse)
+	err := c.cc.Invoke(ctx, MinersService_ListCoins_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) GetCoin(ctx context.Context, in *GetCoinRequest, opts ...grpc.CallOption) (*GetCoinResponse, error) {
+	out := new(GetCoinResponse)
+	err := c.cc.Invoke(ctx, MinersService_GetCoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) CreateCoin(ctx context.Context, in *CreateCoinRequest, opts ...grpc.CallOption) (*CreateCoinResponse, error) {
+	out := new(CreateCoinResponse)
+	err := c.cc.Invoke(ctx, MinersService_CreateCoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) UpdateCoin(ctx context.Context, in *UpdateCoinRequest, opts ...grpc.CallOption) (*UpdateCoinResponse, error) {
+	out := new(UpdateCoinResponse)
+	err := c.cc.Invoke(ctx, MinersService_UpdateCoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) SetCoinActive(ctx context.Context, in *SetCoinActiveRequest, opts ...grpc.CallOption) (*SetCoinActiveResponse, error) {
+	out := new(SetCoinActiveResponse)
+	err := c.cc.Invoke(ctx, MinersService_SetCoinActive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) GetCoinParams(ctx context.Context, in *GetCoinParamsRequest, opts ...grpc.CallOption) (*GetCoinParamsResponse, error) {
+	out := new(GetCoinParamsResponse)
+	err := c.cc.Invoke(ctx, MinersService_GetCoinParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) PatchCoinParams(ctx context.Context, in *PatchCoinParamsRequest, opts ...grpc.CallOption) (*PatchCoinParamsResponse, error) {
+	out := new(PatchCoinParamsResponse)
+	err := c.cc.Invoke(ctx, MinersService_PatchCoinParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) DeleteWallet(ctx context.Context, in *DeleteWalletRequest, opts ...grpc.CallOption) (*DeleteWalletResponse, error) {
+	out := new(DeleteWalletResponse)
+	err := c.cc.Invoke(ctx, MinersService_DeleteWallet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) DeleteWorker(ctx context.Context, in *DeleteWorkerRequest, opts ...grpc.CallOption) (*DeleteWorkerResponse, error) {
+	out := new(DeleteWorkerResponse)
+	err := c.cc.Invoke(ctx, MinersService_DeleteWorker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minersServiceClient) MergeWorkers(ctx context.Context, in *MergeWorkersRequest, opts ...grpc.CallOption) (*MergeWorkersResponse, error) {
+	out := new(MergeWorkersResponse)
+	err := c.cc.Invoke(ctx, MinersService_MergeWorkers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MinersServiceServer is the server API for MinersService service.
 // All implementations must embed UnimplementedMinersServiceServer
 // for forward compatibility
@@ -99,6 +437,39 @@ type MinersServiceServer interface {
 	CreateWorker(context.Context, *CreateWorkerRequest) (*CreateWorkerResponse, error)
 	GetWalletIDByName(context.Context, *GetWalletIDByNameRequest) (*GetWalletIDByNameResponse, error)
 	GetWorkerIDByName(context.Context, *GetWorkerIDByNameRequest) (*GetWorkerIDByNameResponse, error)
+	ResolveMiners(context.Context, *ResolveMinersRequest) (*ResolveMinersResponse, error)
+	StreamResolveMiners(MinersService_StreamResolveMinersServer) error
+	ListRewardMethods(context.Context, *ListRewardMethodsRequest) (*ListRewardMethodsResponse, error)
+	GetWorkerIPHistory(context.Context, *GetWorkerIPHistoryRequest) (*GetWorkerIPHistoryResponse, error)
+	ListWallets(context.Context, *ListWalletsRequest) (*ListWalletsResponse, error)
+	ListWorkersByWallet(context.Context, *ListWorkersByWalletRequest) (*ListWorkersByWalletResponse, error)
+	UpdateWorkerStats(context.Context, *UpdateWorkerStatsRequest) (*UpdateWorkerStatsResponse, error)
+	WatchWorkerStatus(*WatchWorkerStatusRequest, MinersService_WatchWorkerStatusServer) error
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
+	SubmitShares(context.Context, *SubmitSharesRequest) (*SubmitSharesResponse, error)
+	GetShareDuplicateStats(context.Context, *GetShareDuplicateStatsRequest) (*GetShareDuplicateStatsResponse, error)
+	// Порог выплаты кошелька (проверяется по min_withdraw и max_payment_threshold монеты, изменения пишутся в журнал)
+	GetPaymentThreshold(context.Context, *GetPaymentThresholdRequest) (*GetPaymentThresholdResponse, error)
+	SetPaymentThreshold(context.Context, *SetPaymentThresholdRequest) (*SetPaymentThresholdResponse, error)
+	ListPaymentThresholdChanges(context.Context, *ListPaymentThresholdChangesRequest) (*ListPaymentThresholdChangesResponse, error)
+	// Изменение настроек по запросу майнера: запрос возвращает одноразовый токен, изменение применяется только после подтверждения токеном.
+	// RequestSettingsChange доступен только сервисам доставки токена (jwt_relay_services): они передают токен майнеру
+	// по подтвержденному каналу (почта, 2FA) и не возвращают его инициатору запроса
+	RequestSettingsChange(context.Context, *RequestSettingsChangeRequest) (*RequestSettingsChangeResponse, error)
+	ConfirmSettingsChange(context.Context, *ConfirmSettingsChangeRequest) (*ConfirmSettingsChangeResponse, error)
+	// Справочник монет (изменение - только для административных сервисов)
+	ListCoins(context.Context, *ListCoinsRequest) (*ListCoinsResponse, error)
+	GetCoin(context.Context, *GetCoinRequest) (*GetCoinResponse, error)
+	CreateCoin(context.Context, *CreateCoinRequest) (*CreateCoinResponse, error)
+	UpdateCoin(context.Context, *UpdateCoinRequest) (*UpdateCoinResponse, error)
+	SetCoinActive(context.Context, *SetCoinActiveRequest) (*SetCoinActiveResponse, error)
+	GetCoinParams(context.Context, *GetCoinParamsRequest) (*GetCoinParamsResponse, error)
+	PatchCoinParams(context.Context, *PatchCoinParamsRequest) (*PatchCoinParamsResponse, error)
+	// Мягкое удаление и объединение кошельков и воркеров (только для административных сервисов)
+	// удаленные записи не находятся по имени и ID, при повторном подключении создается новая запись
+	DeleteWallet(context.Context, *DeleteWalletRequest) (*DeleteWalletResponse, error)
+	DeleteWorker(context.Context, *DeleteWorkerRequest) (*DeleteWorkerResponse, error)
+	MergeWorkers(context.Context, *MergeWorkersRequest) (*MergeWorkersResponse, error)
 	mustEmbedUnimplementedMinersServiceServer()
 }
 
@@ -121,6 +492,84 @@ func (UnimplementedMinersServiceServer) GetWalletIDByName(context.Context, *GetW
 func (UnimplementedMinersServiceServer) GetWorkerIDByName(context.Context, *GetWorkerIDByNameRequest) (*GetWorkerIDByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerIDByName not implemented")
 }
+func (UnimplementedMinersServiceServer) ResolveMiners(context.Context, *ResolveMinersRequest) (*ResolveMinersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveMiners not implemented")
+}
+func (UnimplementedMinersServiceServer) StreamResolveMiners(MinersService_StreamResolveMinersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamResolveMiners not implemented")
+}
+func (UnimplementedMinersServiceServer) ListRewardMethods(context.Context, *ListRewardMethodsRequest) (*ListRewardMethodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRewardMethods not implemented")
+}
+func (UnimplementedMinersServiceServer) GetWorkerIPHistory(context.Context, *GetWorkerIPHistoryRequest) (*GetWorkerIPHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerIPHistory not implemented")
+}
+func (UnimplementedMinersServiceServer) ListWallets(context.Context, *ListWalletsRequest) (*ListWalletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWallets not implemented")
+}
+func (UnimplementedMinersServiceServer) ListWorkersByWallet(context.Context, *ListWorkersByWalletRequest) (*ListWorkersByWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkersByWallet not implemented")
+}
+func (UnimplementedMinersServiceServer) UpdateWorkerStats(context.Context, *UpdateWorkerStatsRequest) (*UpdateWorkerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerStats not implemented")
+}
+func (UnimplementedMinersServiceServer) WatchWorkerStatus(*WatchWorkerStatusRequest, MinersService_WatchWorkerStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWorkerStatus not implemented")
+}
+func (UnimplementedMinersServiceServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedMinersServiceServer) SubmitShares(context.Context, *SubmitSharesRequest) (*SubmitSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitShares not implemented")
+}
+func (UnimplementedMinersServiceServer) GetShareDuplicateStats(context.Context, *GetShareDuplicateStatsRequest) (*GetShareDuplicateStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShareDuplicateStats not implemented")
+}
+func (UnimplementedMinersServiceServer) GetPaymentThreshold(context.Context, *GetPaymentThresholdRequest) (*GetPaymentThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentThreshold not implemented")
+}
+func (UnimplementedMinersServiceServer) SetPaymentThreshold(context.Context, *SetPaymentThresholdRequest) (*SetPaymentThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaymentThreshold not implemented")
+}
+func (UnimplementedMinersServiceServer) ListPaymentThresholdChanges(context.Context, *ListPaymentThresholdChangesRequest) (*ListPaymentThresholdChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentThresholdChanges not implemented")
+}
+func (UnimplementedMinersServiceServer) RequestSettingsChange(context.Context, *RequestSettingsChangeRequest) (*RequestSettingsChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSettingsChange not implemented")
+}
+func (UnimplementedMinersServiceServer) ConfirmSettingsChange(context.Context, *ConfirmSettingsChangeRequest) (*ConfirmSettingsChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSettingsChange not implemented")
+}
+func (UnimplementedMinersServiceServer) ListCoins(context.Context, *ListCoinsRequest) (*ListCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoins not implemented")
+}
+func (UnimplementedMinersServiceServer) GetCoin(context.Context, *GetCoinRequest) (*GetCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoin not implemented")
+}
+func (UnimplementedMinersServiceServer) CreateCoin(context.Context, *CreateCoinRequest) (*CreateCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCoin not implemented")
+}
+func (UnimplementedMinersServiceServer) UpdateCoin(context.Context, *UpdateCoinRequest) (*UpdateCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCoin not implemented")
+}
+func (UnimplementedMinersServiceServer) SetCoinActive(context.Context, *SetCoinActiveRequest) (*SetCoinActiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoinActive not implemented")
+}
+func (UnimplementedMinersServiceServer) GetCoinParams(context.Context, *GetCoinParamsRequest) (*GetCoinParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoinParams not implemented")
+}
+func (UnimplementedMinersServiceServer) PatchCoinParams(context.Context, *PatchCoinParamsRequest) (*PatchCoinParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchCoinParams not implemented")
+}
+func (UnimplementedMinersServiceServer) DeleteWallet(context.Context, *DeleteWalletRequest) (*DeleteWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWallet not implemented")
+}
+func (UnimplementedMinersServiceServer) DeleteWorker(context.Context, *DeleteWorkerRequest) (*DeleteWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorker not implemented")
+}
+func (UnimplementedMinersServiceServer) MergeWorkers(context.Context, *MergeWorkersRequest) (*MergeWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeWorkers not implemented")
+}
 func (UnimplementedMinersServiceServer) mustEmbedUnimplementedMinersServiceServer() {}
 
 // UnsafeMinersServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -224,34 +673,621 @@ func _MinersService_GetWorkerIDByName_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-// MinersService_ServiceDesc is the grpc.ServiceDesc for MinersService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MinersService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "grpc.MinersService",
-	HandlerType: (*MinersServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetCoinIDByName",
-			Handler:    _MinersService_GetCoinIDByName_Handler,
-		},
-		{
-			MethodName: "CreateWallet",
-			Handler:    _MinersService_CreateWallet_Handler,
-		},
-		{
-			MethodName: "CreateWorker",
-			Handler:    _MinersService_CreateWorker_Handler,
-		},
-		{
-			MethodName: "GetWalletIDByName",
-			Handler:    _MinersService_GetWalletIDByName_Handler,
-		},
-		{
-			MethodName: "GetWorkerIDByName",
-			Handler:    _MinersService_GetWorkerIDByName_Handler,
+func _MinersService_ResolveMiners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveMinersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).ResolveMiners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_ResolveMiners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).ResolveMiners(ctx, req.(*ResolveMinersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_StreamResolveMiners_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MinersServiceServer).StreamResolveMiners(&minersServiceStreamResolveMinersServer{stream})
+}
+
+type MinersService_StreamResolveMinersServer interface {
+	Send(*ResolvedMiner) error
+	Recv() (*MinerIdentity, error)
+	grpc.ServerStream
+}
+
+type minersServiceStreamResolveMinersServer struct {
+	grpc.ServerStream
+}
+
+func (x *minersServiceStreamResolveMinersServer) Send(m *ResolvedMiner) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *minersServiceStreamResolveMinersServer) Recv() (*MinerIdentity, error) {
+	m := new(MinerIdentity)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MinersService_ListRewardMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRewardMethodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).ListRewardMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_ListRewardMethods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).ListRewardMethods(ctx, req.(*ListRewardMethodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_GetWorkerIPHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerIPHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).GetWorkerIPHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_GetWorkerIPHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).GetWorkerIPHistory(ctx, req.(*GetWorkerIPHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_ListWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).ListWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_ListWallets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).ListWallets(ctx, req.(*ListWalletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_ListWorkersByWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkersByWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).ListWorkersByWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_ListWorkersByWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).ListWorkersByWallet(ctx, req.(*ListWorkersByWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_UpdateWorkerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).UpdateWorkerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_UpdateWorkerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).UpdateWorkerStats(ctx, req.(*UpdateWorkerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_WatchWorkerStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWorkerStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MinersServiceServer).WatchWorkerStatus(m, &minersServiceWatchWorkerStatusServer{stream})
+}
+
+type MinersService_WatchWorkerStatusServer interface {
+	Send(*WorkerStatusEvent) error
+	grpc.ServerStream
+}
+
+type minersServiceWatchWorkerStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *minersServiceWatchWorkerStatusServer) Send(m *WorkerStatusEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MinersService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_SubmitShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).SubmitShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_SubmitShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).SubmitShares(ctx, req.(*SubmitSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_GetShareDuplicateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShareDuplicateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).GetShareDuplicateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_GetShareDuplicateStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).GetShareDuplicateStats(ctx, req.(*GetShareDuplicateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_GetPaymentThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).GetPaymentThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_GetPaymentThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).GetPaymentThreshold(ctx, req.(*GetPaymentThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_SetPaymentThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPaymentThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).SetPaymentThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_SetPaymentThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).SetPaymentThreshold(ctx, req.(*SetPaymentThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_ListPaymentThresholdChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentThresholdChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).ListPaymentThresholdChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_ListPaymentThresholdChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).ListPaymentThresholdChanges(ctx, req.(*ListPaymentThresholdChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_RequestSettingsChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestSettingsChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).RequestSettingsChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_RequestSettingsChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).RequestSettingsChange(ctx, req.(*RequestSettingsChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_ConfirmSettingsChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmSettingsChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).ConfirmSettingsChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_ConfirmSettingsChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).ConfirmSettingsChange(ctx, req.(*ConfirmSettingsChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_ListCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).ListCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_ListCoins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).ListCoins(ctx, req.(*ListCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_GetCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).GetCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_GetCoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).GetCoin(ctx, req.(*GetCoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_CreateCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).CreateCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_CreateCoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).CreateCoin(ctx, req.(*CreateCoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_UpdateCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).UpdateCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_UpdateCoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).UpdateCoin(ctx, req.(*UpdateCoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_SetCoinActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCoinActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).SetCoinActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_SetCoinActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).SetCoinActive(ctx, req.(*SetCoinActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_GetCoinParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoinParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).GetCoinParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_GetCoinParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).GetCoinParams(ctx, req.(*GetCoinParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_PatchCoinParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchCoinParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).PatchCoinParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_PatchCoinParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).PatchCoinParams(ctx, req.(*PatchCoinParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_DeleteWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).DeleteWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_DeleteWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).DeleteWallet(ctx, req.(*DeleteWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_DeleteWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).DeleteWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_DeleteWorker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).DeleteWorker(ctx, req.(*DeleteWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinersService_MergeWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinersServiceServer).MergeWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MinersService_MergeWorkers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinersServiceServer).MergeWorkers(ctx, req.(*MergeWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MinersService_ServiceDesc is the grpc.ServiceDesc for MinersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MinersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc.MinersService",
+	HandlerType: (*MinersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCoinIDByName",
+			Handler:    _MinersService_GetCoinIDByName_Handler,
+		},
+		{
+			MethodName: "CreateWallet",
+			Handler:    _MinersService_CreateWallet_Handler,
+		},
+		{
+			MethodName: "CreateWorker",
+			Handler:    _MinersService_CreateWorker_Handler,
+		},
+		{
+			MethodName: "GetWalletIDByName",
+			Handler:    _MinersService_GetWalletIDByName_Handler,
+		},
+		{
+			MethodName: "GetWorkerIDByName",
+			Handler:    _MinersService_GetWorkerIDByName_Handler,
+		},
+		{
+			MethodName: "ResolveMiners",
+			Handler:    _MinersService_ResolveMiners_Handler,
+		},
+		{
+			MethodName: "ListRewardMethods",
+			Handler:    _MinersService_ListRewardMethods_Handler,
+		},
+		{
+			MethodName: "GetWorkerIPHistory",
+			Handler:    _MinersService_GetWorkerIPHistory_Handler,
+		},
+		{
+			MethodName: "ListWallets",
+			Handler:    _MinersService_ListWallets_Handler,
+		},
+		{
+			MethodName: "ListWorkersByWallet",
+			Handler:    _MinersService_ListWorkersByWallet_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerStats",
+			Handler:    _MinersService_UpdateWorkerStats_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _MinersService_GetWallet_Handler,
+		},
+		{
+			MethodName: "SubmitShares",
+			Handler:    _MinersService_SubmitShares_Handler,
+		},
+		{
+			MethodName: "GetShareDuplicateStats",
+			Handler:    _MinersService_GetShareDuplicateStats_Handler,
+		},
+		{
+			MethodName: "GetPaymentThreshold",
+			Handler:    _MinersService_GetPaymentThreshold_Handler,
+		},
+		{
+			MethodName: "SetPaymentThreshold",
+			Handler:    _MinersService_SetPaymentThreshold_Handler,
+		},
+		{
+			MethodName: "ListPaymentThresholdChanges",
+			Handler:    _MinersService_ListPaymentThresholdChanges_Handler,
+		},
+		{
+			MethodName: "RequestSettingsChange",
+			Handler:    _MinersService_RequestSettingsChange_Handler,
+		},
+		{
+			MethodName: "ConfirmSettingsChange",
+			Handler:    _MinersService_ConfirmSettingsChange_Handler,
+		},
+		{
+			MethodName: "ListCoins",
+			Handler:    _MinersService_ListCoins_Handler,
+		},
+		{
+			MethodName: "GetCoin",
+			Handler:    _MinersService_GetCoin_Handler,
+		},
+		{
+			MethodName: "CreateCoin",
+			Handler:    _MinersService_CreateCoin_Handler,
+		},
+		{
+			MethodName: "UpdateCoin",
+			Handler:    _MinersService_UpdateCoin_Handler,
+		},
+		{
+			MethodName: "SetCoinActive",
+			Handler:    _MinersService_SetCoinActive_Handler,
+		},
+		{
+			MethodName: "GetCoinParams",
+			Handler:    _MinersService_GetCoinParams_Handler,
+		},
+		{
+			MethodName: "PatchCoinParams",
+			Handler:    _MinersService_PatchCoinParams_Handler,
+		},
+		{
+			MethodName: "DeleteWallet",
+			Handler:    _MinersService_DeleteWallet_Handler,
+		},
+		{
+			MethodName: "DeleteWorker",
+			Handler:    _MinersService_DeleteWorker_Handler,
+		},
+		{
+			MethodName: "MergeWorkers",
+			Handler:    _MinersService_MergeWorkers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamResolveMiners",
+			Handler:       _MinersService_StreamResolveMiners_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchWorkerStatus",
+			Handler:       _MinersService_WatchWorkerStatus_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/miners.proto",
 }
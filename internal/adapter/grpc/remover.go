@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+)
+
+// DeleteWallet мягкое удаление кошелька вместе с его воркерами (нет или уже удален - codes.NotFound)
+func (s *GRPCServer) DeleteWallet(ctx context.Context, req *proto.DeleteWalletRequest) (*proto.DeleteWalletResponse, error) {
+	_, workers, err := s.remover.DeleteWallet(ctx, req.Id)
+	if err != nil {
+		return nil, statusError("DeleteWallet", err)
+	}
+
+	return &proto.DeleteWalletResponse{
+		WorkersDeleted: int32(len(workers)),
+	}, nil
+}
+
+// DeleteWorker мягкое удаление воркера (нет или уже удален - codes.NotFound)
+func (s *GRPCServer) DeleteWorker(ctx context.Context, req *proto.DeleteWorkerRequest) (*proto.DeleteWorkerResponse, error) {
+	if _, err := s.remover.DeleteWorker(ctx, req.Id); err != nil {
+		return nil, statusError("DeleteWorker", err)
+	}
+
+	return &proto.DeleteWorkerResponse{}, nil
+}
+
+// MergeWorkers перенос воркера source в target с удалением source
+// воркеры разных кошельков (монет, методов начисления) - codes.FailedPrecondition
+func (s *GRPCServer) MergeWorkers(ctx context.Context, req *proto.MergeWorkersRequest) (*proto.MergeWorkersResponse, error) {
+	if req.SourceId == req.TargetId {
+		return nil, invalidArgument("MergeWorkers", "target_id: must differ from source_id")
+	}
+
+	_, err := s.remover.MergeWorkers(ctx, req.SourceId, req.TargetId)
+	switch {
+	case errors.Is(err, storage.ErrWorkersMismatch):
+		return nil, statusWithDetail(codes.FailedPrecondition, "MergeWorkers", err.Error())
+	case err != nil:
+		return nil, statusError("MergeWorkers", err)
+	}
+
+	return &proto.MergeWorkersResponse{}, nil
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// соответствие кодов методов начисления вознаграждения значениям proto.RewardMethod
+var rewardMethodToProto = map[entity.RewardMethod]proto.RewardMethod{
+	entity.RewardMethodPPLNS:   proto.RewardMethod_REWARD_METHOD_PPLNS,
+	entity.RewardMethodPPS:     proto.RewardMethod_REWARD_METHOD_PPS,
+	entity.RewardMethodPPSPlus: proto.RewardMethod_REWARD_METHOD_PPS_PLUS,
+	entity.RewardMethodFPPS:    proto.RewardMethod_REWARD_METHOD_FPPS,
+	entity.RewardMethodSOLO:    proto.RewardMethod_REWARD_METHOD_SOLO,
+	entity.RewardMethodPROP:    proto.RewardMethod_REWARD_METHOD_PROP,
+}
+
+// ListRewardMethods методы начисления вознаграждения, доступные для монеты
+func (s *GRPCServer) ListRewardMethods(ctx context.Context, req *proto.ListRewardMethodsRequest) (*proto.ListRewardMethodsResponse, error) {
+	methods, err := s.rewardMethods.ListRewardMethodsByCoin(ctx, req.CoinId)
+	if err != nil {
+		return nil, statusError("ListRewardMethods", err)
+	}
+
+	resp := &proto.ListRewardMethodsResponse{
+		Methods: make([]*proto.RewardMethodInfo, len(methods)),
+	}
+	for i, m := range methods {
+		resp.Methods[i] = &proto.RewardMethodInfo{
+			Method: rewardMethodToProto[m.Code],
+			Code:   string(m.Code),
+			Name:   m.Name,
+		}
+	}
+
+	return resp, nil
+}
+
+// checkRewardMethod проверка доступности метода начисления вознаграждения для монеты
+// (ошибка хранилища преобразуется по модели ошибок версии сервиса)
+func (s *GRPCServer) checkRewardMethod(ctx context.Context, method string, coinID int64, rewardMethod entity.RewardMethod, toStatus errorModel) error {
+	methods, err := s.rewardMethods.ListRewardMethodsByCoin(ctx, coinID)
+	if err != nil {
+		return toStatus(method, err)
+	}
+
+	for _, m := range methods {
+		if m.Code == rewardMethod {
+			return nil
+		}
+	}
+
+	return invalidArgument(method, fmt.Sprintf("reward method %q is not supported for coin %d", rewardMethod, coinID))
+}
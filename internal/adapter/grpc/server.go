@@ -2,47 +2,87 @@ package grpc
 
 import (
 	"context"
-	"time"
-
-	"github.com/jackc/pgx/v4"
-	"github.com/jackc/pgx/v4/pgxpool"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/dedup"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+	"github.com/dnsoftware/mpm-miners-processor/internal/monitor"
+	"github.com/dnsoftware/mpm-miners-processor/internal/reward"
 )
 
 type GRPCServer struct {
 	proto.UnimplementedMinersServiceServer
-	pool *pgxpool.Pool
+	coins           storage.CoinRepository
+	wallets         storage.WalletRepository
+	workers         storage.WorkerRepository
+	miners          storage.MinerResolver
+	rewardMethods   storage.RewardMethodRepository
+	workerStats     storage.WorkerStatsUpdater
+	settingsChanges storage.SettingsChangeRepository
+	remover         storage.MinerRemover
+	shares          SharesForwarder
+	duplicates      *dedup.Detector
+	rewards         *reward.Registry
+	rewardParams    reward.ParamsByCoin
+	workerEvents    *monitor.Broker
+	deprecated      deprecatedUsage
+}
+
+// Dependencies зависимости сервера (нулевые значения Shares, Duplicates и WorkerEvents отключают
+// прием шар, поиск повторов и WatchWorkerStatus)
+type Dependencies struct {
+	Coins           storage.CoinRepository
+	Wallets         storage.WalletRepository
+	Workers         storage.WorkerRepository
+	Miners          storage.MinerResolver
+	RewardMethods   storage.RewardMethodRepository
+	WorkerStats     storage.WorkerStatsUpdater
+	SettingsChanges storage.SettingsChangeRepository
+	Remover         storage.MinerRemover
+	Shares          SharesForwarder
+	Duplicates      *dedup.Detector
+	Rewards         *reward.Registry    // nil - стоимость шар не рассчитывается
+	RewardParams    reward.ParamsByCoin // условия расчета стоимости шар по монетам
+	WorkerEvents    *monitor.Broker
 }
 
-func NewGRPCServer(pool *pgxpool.Pool) (*GRPCServer, error) {
+func NewGRPCServer(deps Dependencies) (*GRPCServer, error) {
 	s := &GRPCServer{
-		pool: pool,
+		coins:           deps.Coins,
+		wallets:         deps.Wallets,
+		workers:         deps.Workers,
+		miners:          deps.Miners,
+		rewardMethods:   deps.RewardMethods,
+		workerStats:     deps.WorkerStats,
+		settingsChanges: deps.SettingsChanges,
+		remover:         deps.Remover,
+		shares:          deps.Shares,
+		duplicates:      deps.Duplicates,
+		rewards:         deps.Rewards,
+		rewardParams:    deps.RewardParams,
+		workerEvents:    deps.WorkerEvents,
 	}
 
 	return s, nil
 }
 
+// GetCoinIDByName ошибки версии 1 - codes.Internal (в том числе для неизвестной монеты)
 func (s *GRPCServer) GetCoinIDByName(ctx context.Context, req *proto.GetCoinIDByNameRequest) (*proto.GetCoinIDByNameResponse, error) {
+	return s.getCoinIDByName(ctx, req, internalError)
+}
 
-	var id int64
+func (s *GRPCServer) getCoinIDByName(ctx context.Context, req *proto.GetCoinIDByNameRequest, toStatus errorModel) (*proto.GetCoinIDByNameResponse, error) {
 
-	err := s.pool.QueryRow(ctx, `SELECT id FROM coins WHERE symbol = $1`, req.Coin).Scan(&id)
+	id, err := s.coins.GetCoinIDBySymbol(ctx, req.Coin, req.IncludeInactive)
 
-	// Ошибка
+	// Ошибка (код зависит от версии сервиса)
 	if err != nil {
-		// Возвращаем ошибку
-		st := status.New(codes.Internal, err.Error())
-		// Добавляем дополнительные детали, если нужно (тут сделано для примера, как это работает)
-		detail := &proto.MPError{
-			Method:      "GetCoinIDByName",
-			Description: "QueryRow error: " + err.Error(),
-		}
-		st, _ = st.WithDetails(detail)
-
-		return nil, st.Err() // возвращаем ошибку клиенту
+		return nil, toStatus("GetCoinIDByName", err) // возвращаем ошибку клиенту с деталями MPError
 	}
 
 	resp := &proto.GetCoinIDByNameResponse{
@@ -53,30 +93,24 @@ func (s *GRPCServer) GetCoinIDByName(ctx context.Context, req *proto.GetCoinIDBy
 }
 
 func (s *GRPCServer) CreateWallet(ctx context.Context, req *proto.CreateWalletRequest) (*proto.CreateWalletResponse, error) {
-	var newID int64
+	return s.createWallet(ctx, req, internalError)
+}
 
-	// Проверка на существование
-	check, err := s.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{
-		Wallet:       req.Name,
-		CoinId:       req.CoinId,
-		RewardMethod: req.RewardMethod,
-	})
-	if err != nil {
-		st := status.New(codes.Internal, err.Error())
-		return nil, st.Err()
-	}
-	if check.Id > 0 {
-		return &proto.CreateWalletResponse{Id: check.Id}, nil
+func (s *GRPCServer) createWallet(ctx context.Context, req *proto.CreateWalletRequest, toStatus errorModel) (*proto.CreateWalletResponse, error) {
+	// Метод начисления вознаграждения должен быть доступен для монеты
+	if err := s.checkRewardMethod(ctx, "CreateWallet", req.CoinId, entity.RewardMethod(req.RewardMethod), toStatus); err != nil {
+		return nil, err
 	}
+	s.noteIsSolo(ctx, "CreateWallet", req.GetIsSolo(), entity.RewardMethod(req.RewardMethod))
 
-	// Вставка новой записи
-	err = s.pool.QueryRow(ctx, `INSERT INTO wallets (coin_id, name, is_solo, reward_method) 
-			VALUES ($1, $2, $3, $4) RETURNING id`,
-		req.CoinId, req.Name, req.IsSolo, req.RewardMethod).Scan(&newID)
-
+	// Вставка новой записи (или получение ID существующей)
+	newID, err := s.wallets.CreateWallet(ctx, entity.Wallet{
+		CoinID:       req.CoinId,
+		Name:         req.Name,
+		RewardMethod: entity.RewardMethod(req.RewardMethod),
+	})
 	if err != nil {
-		st := status.New(codes.Internal, err.Error())
-		return nil, st.Err()
+		return nil, toStatus("CreateWallet", err)
 	}
 
 	resp := &proto.CreateWalletResponse{
@@ -87,33 +121,28 @@ func (s *GRPCServer) CreateWallet(ctx context.Context, req *proto.CreateWalletRe
 }
 
 func (s *GRPCServer) CreateWorker(ctx context.Context, req *proto.CreateWorkerRequest) (*proto.CreateWorkerResponse, error) {
-	created_at := time.Now().Format("2006-01-02 15:04:05.000")
-	updated_at := time.Now().Format("2006-01-02 15:04:05.000")
+	return s.createWorker(ctx, req, internalError)
+}
 
-	var newID int64
+func (s *GRPCServer) createWorker(ctx context.Context, req *proto.CreateWorkerRequest, toStatus errorModel) (*proto.CreateWorkerResponse, error) {
+	// Метод начисления вознаграждения должен быть доступен для монеты
+	if err := s.checkRewardMethod(ctx, "CreateWorker", req.CoinId, entity.RewardMethod(req.RewardMethod), toStatus); err != nil {
+		return nil, err
+	}
+	s.noteIsSolo(ctx, "CreateWorker", req.GetIsSolo(), entity.RewardMethod(req.RewardMethod))
 
-	// Проверка на существование
-	check, err := s.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{
+	// Вставка новой записи (или получение ID существующей)
+	newID, err := s.workers.CreateWorker(ctx, entity.Worker{
+		CoinID:       req.CoinId,
 		Workerfull:   req.Workerfull,
-		CoinId:       req.CoinId,
-		RewardMethod: req.RewardMethod,
+		Wallet:       req.Wallet,
+		Worker:       req.Worker,
+		ServerID:     req.ServerId,
+		IP:           workerIP(req.Ip),
+		RewardMethod: entity.RewardMethod(req.RewardMethod),
 	})
 	if err != nil {
-		st := status.New(codes.Internal, err.Error())
-		return nil, st.Err()
-	}
-	if check.Id > 0 {
-		return &proto.CreateWorkerResponse{Id: check.Id}, nil
-	}
-
-	// Вставка новой записи
-	err = s.pool.QueryRow(ctx, `INSERT INTO workers (coin_id, workerfull, wallet, worker, server_id, created_at, updated_at, reward_method) 
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`,
-		req.CoinId, req.Workerfull, req.Wallet, req.Worker, req.ServerId, created_at, updated_at, req.RewardMethod).Scan(&newID)
-
-	if err != nil {
-		st := status.New(codes.Internal, err.Error())
-		return nil, st.Err()
+		return nil, toStatus("CreateWorker", err)
 	}
 
 	resp := &proto.CreateWorkerResponse{
@@ -124,47 +153,138 @@ func (s *GRPCServer) CreateWorker(ctx context.Context, req *proto.CreateWorkerRe
 }
 
 func (s *GRPCServer) GetWalletIDByName(ctx context.Context, req *proto.GetWalletIDByNameRequest) (*proto.GetWalletIDByNameResponse, error) {
-	var id int64
-	err := s.pool.QueryRow(ctx, `SELECT id FROM wallets WHERE name = $1 AND coin_id = $2 AND reward_method = $3`,
-		req.Wallet, req.CoinId, req.RewardMethod).Scan(&id)
+	wallet, err := s.wallets.GetWalletByName(ctx, req.Wallet, req.CoinId, entity.RewardMethod(req.RewardMethod))
 
 	if err != nil {
-		if err == pgx.ErrNoRows {
+		if errors.Is(err, storage.ErrNotFound) {
 			// Если нет записей
 			return &proto.GetWalletIDByNameResponse{
 				Id: 0,
 			}, nil
 		} else {
 			// Обработка других ошибок
-			st := status.New(codes.Internal, err.Error())
 			return &proto.GetWalletIDByNameResponse{
 				Id: 0,
-			}, st.Err()
+			}, internalError("GetWalletIDByName", err)
 		}
 	}
 
 	return &proto.GetWalletIDByNameResponse{
-		Id: id,
+		Id: wallet.ID,
 	}, nil
 
 }
 
 func (s *GRPCServer) GetWorkerIDByName(ctx context.Context, req *proto.GetWorkerIDByNameRequest) (*proto.GetWorkerIDByNameResponse, error) {
-	var id int64
-	err := s.pool.QueryRow(ctx, `SELECT id FROM workers WHERE workerfull = $1 AND coin_id = $2 AND reward_method = $3`,
-		req.Workerfull, req.CoinId, req.RewardMethod).Scan(&id)
+	worker, err := s.workers.GetWorkerByName(ctx, req.Workerfull, req.CoinId, entity.RewardMethod(req.RewardMethod))
 
 	if err != nil {
-		if err == pgx.ErrNoRows {
+		if errors.Is(err, storage.ErrNotFound) {
 			// Если нет записей
 			return &proto.GetWorkerIDByNameResponse{Id: 0}, nil
 		} else {
 			// Обработка других ошибок
-			st := status.New(codes.Internal, err.Error())
-			return &proto.GetWorkerIDByNameResponse{Id: 0}, st.Err()
+			return &proto.GetWorkerIDByNameResponse{Id: 0}, internalError("GetWorkerIDByName", err)
 		}
 	}
 
-	return &proto.GetWorkerIDByNameResponse{Id: id}, nil
+	return &proto.GetWorkerIDByNameResponse{Id: worker.ID}, nil
+
+}
+
+// ResolveMiners пакетное получение ID монет, кошельков и воркеров (недостающие кошельки и воркеры создаются)
+func (s *GRPCServer) ResolveMiners(ctx context.Context, req *proto.ResolveMinersRequest) (*proto.ResolveMinersResponse, error) {
+	if len(req.Miners) > constants.ResolveMinersMaxBatch {
+		return nil, invalidArgument("ResolveMiners", fmt.Sprintf("too many miners in request: %d, max %d", len(req.Miners), constants.ResolveMinersMaxBatch))
+	}
+
+	resolved, err := s.resolveMiners(ctx, req.Miners)
+	if err != nil {
+		return nil, internalError("ResolveMiners", err)
+	}
+
+	return &proto.ResolveMinersResponse{Miners: resolved}, nil
+}
+
+// resolveMiners получение ID для пакета идентификационных данных воркеров (ответ в том же порядке)
+// метод начисления проверяется для каждого элемента, как в CreateWallet и CreateWorker: для недоступного
+// для монеты метода кошелек и воркер не создаются, в ответе заполнен только ID монеты
+func (s *GRPCServer) resolveMiners(ctx context.Context, req []*proto.MinerIdentity) ([]*proto.ResolvedMiner, error) {
+	miners := make([]entity.MinerIdentity, 0, len(req))
+	positions := make([]int, 0, len(req)) // индекс в запросе для каждого элемента miners
+	resolved := make([]*proto.ResolvedMiner, len(req))
+	unavailable, err := s.unavailableRewardMethods(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for i, m := range req {
+		if coinID, ok := unavailable[i]; ok {
+			resolved[i] = &proto.ResolvedMiner{CoinId: coinID}
+			continue
+		}
+		positions = append(positions, i)
+		miners = append(miners, entity.MinerIdentity{
+			Coin:         m.Coin,
+			Wallet:       m.Wallet,
+			Workerfull:   m.Workerfull,
+			Worker:       strings.TrimPrefix(m.Workerfull, m.Wallet+"."),
+			ServerID:     m.ServerId,
+			IP:           workerIP(m.Ip),
+			RewardMethod: entity.RewardMethod(m.RewardMethod),
+		})
+	}
+
+	ids, err := s.miners.ResolveMiners(ctx, miners)
+	if err != nil {
+		return nil, err
+	}
+
+	for j, id := range ids {
+		resolved[positions[j]] = &proto.ResolvedMiner{
+			CoinId:   id.CoinID,
+			WalletId: id.WalletID,
+			WorkerId: id.WorkerID,
+		}
+	}
+
+	return resolved, nil
+}
+
+// unavailableRewardMethods элементы с методом начисления, недоступным для монеты (индекс => ID монеты)
+// неизвестные и выключенные монеты сюда не попадают - их отсекает MinerResolver
+func (s *GRPCServer) unavailableRewardMethods(ctx context.Context, req []*proto.MinerIdentity) (map[int]int64, error) {
+	coinIDs := make(map[string]int64)                       // символ => ID монеты (0 - не найдена)
+	methods := make(map[int64]map[entity.RewardMethod]bool) // ID монеты => доступные методы
+	unavailable := make(map[int]int64)
+	for i, m := range req {
+		coinID, ok := coinIDs[m.Coin]
+		if !ok {
+			id, err := s.coins.GetCoinIDBySymbol(ctx, m.Coin, false)
+			if err != nil && !errors.Is(err, storage.ErrNotFound) {
+				return nil, err
+			}
+			coinID, coinIDs[m.Coin] = id, id
+		}
+		if coinID == 0 {
+			continue
+		}
+
+		available, ok := methods[coinID]
+		if !ok {
+			list, err := s.rewardMethods.ListRewardMethodsByCoin(ctx, coinID)
+			if err != nil {
+				return nil, err
+			}
+			available = make(map[entity.RewardMethod]bool, len(list))
+			for _, rm := range list {
+				available[rm.Code] = true
+			}
+			methods[coinID] = available
+		}
+		if !available[entity.RewardMethod(m.RewardMethod)] {
+			unavailable[i] = coinID
+		}
+	}
 
+	return unavailable, nil
 }
@@ -0,0 +1,903 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage/memory"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/dedup"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+	"github.com/dnsoftware/mpm-miners-processor/internal/metrics"
+	"github.com/dnsoftware/mpm-miners-processor/internal/reward"
+)
+
+func newTestServer(t *testing.T) *GRPCServer {
+	coins := memory.NewCoinRepository(map[string]int64{"ALPH": 4})
+	wallets := memory.NewWalletRepository()
+	workers := memory.NewWorkerRepository()
+	rewardMethods := memory.NewRewardMethodRepository(map[int64][]entity.RewardMethod{4: entity.RewardMethods})
+	s, err := NewGRPCServer(memoryDependencies(coins, wallets, workers, rewardMethods))
+	require.NoError(t, err)
+
+	return s
+}
+
+// memoryDependencies зависимости сервера на хранилищах в памяти (без приема шар и событий воркеров)
+func memoryDependencies(coins *memory.CoinRepository, wallets *memory.WalletRepository, workers *memory.WorkerRepository,
+	rewardMethods *memory.RewardMethodRepository) Dependencies {
+	return Dependencies{
+		Coins:           coins,
+		Wallets:         wallets,
+		Workers:         workers,
+		Miners:          memory.NewMinerResolver(coins, wallets, workers),
+		RewardMethods:   rewardMethods,
+		WorkerStats:     memory.NewWorkerStatsUpdater(wallets, workers),
+		SettingsChanges: memory.NewSettingsChangeRepository(coins, wallets),
+		Remover:         memory.NewMinerRemover(wallets, workers),
+	}
+}
+
+func TestGRPCServerMemory(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	// Coin
+	coin, err := s.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "ALPH"})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), coin.Id)
+
+	_, err = s.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "NONAME"})
+	require.Error(t, err)
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	// Wallet
+	wallet, err := s.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), wallet.Id)
+
+	res, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Id)
+
+	// Проверка на повторную вставку
+	resD, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, res.Id, resD.Id)
+
+	wallet, err = s.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, res.Id, wallet.Id)
+
+	// Worker
+	workerReq := &proto.CreateWorkerRequest{
+		CoinId:       4,
+		Workerfull:   "wallet.worker",
+		Wallet:       "wallet",
+		Worker:       "worker",
+		ServerId:     "SERV",
+		Ip:           "127.0.0.1",
+		RewardMethod: "PPLNS",
+	}
+	res3, err := s.CreateWorker(ctx, workerReq)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res3.Id)
+
+	// Проверка на повторную вставку
+	res5, err := s.CreateWorker(ctx, workerReq)
+	require.NoError(t, err)
+	require.Equal(t, res3.Id, res5.Id)
+
+	res4, err := s.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{Workerfull: "wallet.worker", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, res3.Id, res4.Id)
+
+	// другой метод начисления - другой воркер
+	res6, err := s.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{Workerfull: "wallet.worker", CoinId: 4, RewardMethod: "SOLO"})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res6.Id)
+}
+
+func TestGRPCServerResolveMiners(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	// кошелек, созданный ранее обычным способом
+	walletRes, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+
+	res, err := s.ResolveMiners(ctx, &proto.ResolveMinersRequest{
+		Miners: []*proto.MinerIdentity{
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig1", ServerId: "SERV", Ip: "10.0.0.1", RewardMethod: "PPLNS"},
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig2", ServerId: "SERV", Ip: "10.0.0.2", RewardMethod: "PPLNS"},
+			{Coin: "NONAME", Wallet: "wallet", Workerfull: "wallet.rig1", ServerId: "SERV", RewardMethod: "PPLNS"},
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig1", ServerId: "SERV", Ip: "10.0.0.1", RewardMethod: "PPLNS"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Miners, 4)
+
+	require.Equal(t, int64(4), res.Miners[0].CoinId)
+	require.Equal(t, walletRes.Id, res.Miners[0].WalletId)
+	require.Equal(t, walletRes.Id, res.Miners[1].WalletId)
+	require.NotEqual(t, res.Miners[0].WorkerId, res.Miners[1].WorkerId)
+
+	// неизвестная монета
+	require.Equal(t, int64(0), res.Miners[2].CoinId)
+	require.Equal(t, int64(0), res.Miners[2].WalletId)
+	require.Equal(t, int64(0), res.Miners[2].WorkerId)
+
+	// повтор в пакете
+	require.Equal(t, res.Miners[0].WorkerId, res.Miners[3].WorkerId)
+
+	worker, err := s.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{Workerfull: "wallet.rig2", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, res.Miners[1].WorkerId, worker.Id)
+
+	// ограничение размера пакета
+	_, err = s.ResolveMiners(ctx, &proto.ResolveMinersRequest{Miners: make([]*proto.MinerIdentity, constants.ResolveMinersMaxBatch+1)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCServerRewardMethods(t *testing.T) {
+	ctx := context.Background()
+	coins := memory.NewCoinRepository(map[string]int64{"ALPH": 4, "KAS": 8})
+	wallets := memory.NewWalletRepository()
+	workers := memory.NewWorkerRepository()
+	rewardMethods := memory.NewRewardMethodRepository(map[int64][]entity.RewardMethod{
+		4: entity.RewardMethods,
+		8: {entity.RewardMethodPPLNS, entity.RewardMethodSOLO},
+	})
+	s, err := NewGRPCServer(memoryDependencies(coins, wallets, workers, rewardMethods))
+	require.NoError(t, err)
+
+	res, err := s.ListRewardMethods(ctx, &proto.ListRewardMethodsRequest{CoinId: 8})
+	require.NoError(t, err)
+	require.Len(t, res.Methods, 2)
+	require.Equal(t, proto.RewardMethod_REWARD_METHOD_PPLNS, res.Methods[0].Method)
+	require.Equal(t, "PPLNS", res.Methods[0].Code)
+	require.Equal(t, proto.RewardMethod_REWARD_METHOD_SOLO, res.Methods[1].Method)
+
+	// метод не доступен для монеты
+	_, err = s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 8, Name: "wallet", RewardMethod: "PPS"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.CreateWorker(ctx, &proto.CreateWorkerRequest{CoinId: 8, Workerfull: "wallet.rig", Wallet: "wallet", Worker: "rig", ServerId: "SERV", RewardMethod: "FPPS"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 8, Name: "wallet", RewardMethod: "SOLO"})
+	require.NoError(t, err)
+
+	// пакетное получение: кошелек и воркер с недоступным методом не создаются, остальные элементы обрабатываются
+	resolved, err := s.ResolveMiners(ctx, &proto.ResolveMinersRequest{Miners: []*proto.MinerIdentity{
+		{Coin: "KAS", Wallet: "wallet", Workerfull: "wallet.rig", ServerId: "SERV", RewardMethod: "PPS"},
+		{Coin: "KAS", Wallet: "wallet", Workerfull: "wallet.rig", ServerId: "SERV", RewardMethod: "PPLNS"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, int64(8), resolved.Miners[0].CoinId)
+	require.Zero(t, resolved.Miners[0].WalletId)
+	require.Zero(t, resolved.Miners[0].WorkerId)
+	require.NotZero(t, resolved.Miners[1].WorkerId)
+
+	wallet, err := s.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{Wallet: "wallet", CoinId: 8, RewardMethod: "PPS"})
+	require.NoError(t, err)
+	require.Zero(t, wallet.Id)
+}
+
+func TestGRPCServerDeprecatedIsSolo(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	_, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), s.DeprecatedIsSoloCount())
+
+	_, err = s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", IsSolo: true, RewardMethod: "SOLO"})
+	require.NoError(t, err)
+	_, err = s.CreateWorker(ctx, &proto.CreateWorkerRequest{CoinId: 4, Workerfull: "wallet.rig", Wallet: "wallet", Worker: "rig", ServerId: "SERV", IsSolo: true, RewardMethod: "SOLO"})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), s.DeprecatedIsSoloCount())
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.DeprecatedFieldRequests.WithLabelValues("CreateWorker", "is_solo", "anonymous")))
+
+	// адрес клиента без JWT в метку не попадает
+	peerCtx := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 5000}})
+	_, err = s.CreateWorker(peerCtx, &proto.CreateWorkerRequest{CoinId: 4, Workerfull: "wallet.rig", Wallet: "wallet", Worker: "rig", ServerId: "SERV", IsSolo: true, RewardMethod: "SOLO"})
+	require.NoError(t, err)
+	require.Equal(t, float64(2), testutil.ToFloat64(metrics.DeprecatedFieldRequests.WithLabelValues("CreateWorker", "is_solo", "anonymous")))
+}
+
+func TestGRPCServerWorkerIPHistory(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	req := &proto.CreateWorkerRequest{CoinId: 4, Workerfull: "wallet.rig", Wallet: "wallet", Worker: "rig", ServerId: "SERV", Ip: "10.0.0.1", RewardMethod: "PPLNS"}
+	id, err := s.CreateWorker(ctx, req)
+	require.NoError(t, err)
+
+	// переподключение с нового адреса
+	req.Ip = "10.0.0.2"
+	id2, err := s.CreateWorker(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, id.Id, id2.Id)
+
+	// без адреса история не меняется
+	req.Ip = ""
+	_, err = s.CreateWorker(ctx, req)
+	require.NoError(t, err)
+
+	res, err := s.GetWorkerIPHistory(ctx, &proto.GetWorkerIPHistoryRequest{WorkerId: id.Id})
+	require.NoError(t, err)
+	require.Len(t, res.History, 2)
+	require.Equal(t, "10.0.0.2", res.History[0].Ip)
+	require.Equal(t, "10.0.0.1", res.History[1].Ip)
+	require.NotZero(t, res.History[0].FirstSeen)
+
+	// IPv6 адрес сохраняется в канонической форме
+	req.Ip = "2001:DB8:0:0:0:0:0:1"
+	_, err = s.CreateWorker(ctx, req)
+	require.NoError(t, err)
+
+	res, err = s.GetWorkerIPHistory(ctx, &proto.GetWorkerIPHistoryRequest{WorkerId: id.Id})
+	require.NoError(t, err)
+	require.Equal(t, "2001:db8::1", res.History[0].Ip)
+
+	res, err = s.GetWorkerIPHistory(ctx, &proto.GetWorkerIPHistoryRequest{WorkerId: id.Id, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, res.History, 1)
+
+	_, err = s.GetWorkerIPHistory(ctx, &proto.GetWorkerIPHistoryRequest{WorkerId: 100})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPCServerCoins(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	newID, err := s.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "KAS", Name: "Kaspa", Algo: "kHeavyHash", MinWithdraw: &proto.Decimal{Value: "1.5"}}})
+	require.NoError(t, err)
+
+	_, err = s.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "KAS"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	coin, err := s.GetCoin(ctx, &proto.GetCoinRequest{Id: newID.Id})
+	require.NoError(t, err)
+	require.Equal(t, "Kaspa", coin.Coin.Name)
+	require.Equal(t, "1.5", coin.Coin.MinWithdraw.GetValue())
+	require.False(t, coin.Coin.IsActive)
+
+	// символ другой монеты занят
+	_, err = s.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: &proto.Coin{Id: newID.Id, Symbol: "ALPH"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	updated, err := s.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: &proto.Coin{Id: newID.Id, Symbol: "KAS", Name: "Kaspa Network", IsActive: true}})
+	require.NoError(t, err)
+	require.Equal(t, "Kaspa Network", updated.Coin.Name)
+	require.False(t, updated.Coin.IsActive) // is_active меняется только через SetCoinActive
+
+	_, err = s.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: &proto.Coin{Id: 100, Symbol: "NONAME"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	list, err := s.ListCoins(ctx, &proto.ListCoinsRequest{ActiveOnly: true})
+	require.NoError(t, err)
+	require.Len(t, list.Coins, 1)
+
+	_, err = s.SetCoinActive(ctx, &proto.SetCoinActiveRequest{Id: newID.Id, IsActive: true})
+	require.NoError(t, err)
+	list, err = s.ListCoins(ctx, &proto.ListCoinsRequest{ActiveOnly: true})
+	require.NoError(t, err)
+	require.Len(t, list.Coins, 2)
+
+	_, err = s.SetCoinActive(ctx, &proto.SetCoinActiveRequest{Id: 100})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPCServerCoinParams(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	created, err := s.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{
+		Symbol: "KAS",
+		Params: &proto.CoinParams{CurrencyRates: map[string]*proto.Decimal{"USD": {Value: "0.1"}},
+			CurrentRewardPerGigahashMinerstat: &proto.Decimal{Value: "348.34020821160004"}},
+	}})
+	require.NoError(t, err)
+
+	_, err = s.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{
+		Symbol: "BAD",
+		Params: &proto.CoinParams{CurrentRewardPerGigahash: &proto.Decimal{Value: "-1"}},
+	}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{
+		Symbol: "BAD",
+		Params: &proto.CoinParams{CurrencyRates: map[string]*proto.Decimal{"USD": {Value: "1e3"}}},
+	}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	reward := &proto.Decimal{Value: "0.25"}
+	patched, err := s.PatchCoinParams(ctx, &proto.PatchCoinParamsRequest{
+		CoinId:                   created.Id,
+		CurrencyRates:            map[string]*proto.Decimal{"EUR": {Value: "0.09"}},
+		CurrentRewardPerGigahash: reward,
+	})
+	require.NoError(t, err)
+	require.Len(t, patched.Params.CurrencyRates, 2)
+	require.Equal(t, "0.1", patched.Params.CurrencyRates["USD"].Value)
+	require.Equal(t, "0.09", patched.Params.CurrencyRates["EUR"].Value)
+	require.Equal(t, "0.25", patched.Params.CurrentRewardPerGigahash.Value)
+	// значения без потери точности
+	require.Equal(t, "348.34020821160004", patched.Params.CurrentRewardPerGigahashMinerstat.Value)
+
+	params, err := s.GetCoinParams(ctx, &proto.GetCoinParamsRequest{CoinId: created.Id})
+	require.NoError(t, err)
+	require.Equal(t, "0.09", params.Params.CurrencyRates["EUR"].Value)
+
+	_, err = s.PatchCoinParams(ctx, &proto.PatchCoinParamsRequest{CoinId: created.Id})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.PatchCoinParams(ctx, &proto.PatchCoinParamsRequest{CoinId: created.Id, CurrentRewardPerGigahashPps: &proto.Decimal{Value: "-0.5"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.PatchCoinParams(ctx, &proto.PatchCoinParamsRequest{CoinId: 100, CurrentRewardPerGigahash: reward})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPCServerCoinSymbolLookup(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	kas, err := s.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "KAS", Symbol2: "KASPA"}})
+	require.NoError(t, err)
+
+	// символы заняты без учета регистра (в том числе альтернативным символом)
+	_, err = s.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "kas"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = s.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "Kaspa"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = s.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "NEW", Symbol2: "alph"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	// монета выключена - находится только по запросу (версия 1 отвечает codes.Internal)
+	// монета выключена - находится только по запросу
+	_, err = s.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "kas"})
+	require.Equal(t, codes.Internal, status.Code(err))
+	res, err := s.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "kas", IncludeInactive: true})
+	require.NoError(t, err)
+	require.Equal(t, kas.Id, res.Id)
+
+	_, err = s.SetCoinActive(ctx, &proto.SetCoinActiveRequest{Id: kas.Id, IsActive: true})
+	require.NoError(t, err)
+
+	for _, symbol := range []string{"KAS", "kas", "Kaspa", "KASPA"} {
+		res, err = s.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: symbol})
+		require.NoError(t, err, symbol)
+		require.Equal(t, kas.Id, res.Id, symbol)
+	}
+
+	res, err = s.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "alph"})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), res.Id)
+}
+
+func TestGRPCServerListWallets(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	for _, name := range []string{"bbb", "aaa", "abc", "ccc", "ab_"} {
+		_, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: name, RewardMethod: "PPLNS"})
+		require.NoError(t, err)
+	}
+	_, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "abd", RewardMethod: "SOLO"})
+	require.NoError(t, err)
+
+	names := func(wallets []*proto.WalletInfo) []string {
+		res := make([]string, len(wallets))
+		for i, w := range wallets {
+			res[i] = w.Name
+		}
+		return res
+	}
+
+	// Постраничный вывод по имени
+	req := &proto.ListWalletsRequest{Sort: proto.WalletSortField_WALLET_SORT_NAME, PageSize: 4}
+	page1, err := s.ListWallets(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"aaa", "ab_", "abc", "abd"}, names(page1.Wallets))
+	require.NotEmpty(t, page1.NextPageToken)
+	require.NotZero(t, page1.Wallets[0].CreatedAt)
+
+	req.PageToken = page1.NextPageToken
+	page2, err := s.ListWallets(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bbb", "ccc"}, names(page2.Wallets))
+	require.Empty(t, page2.NextPageToken)
+
+	// По ID в обратном порядке
+	res, err := s.ListWallets(ctx, &proto.ListWalletsRequest{Desc: true, PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"abd", "ab_"}, names(res.Wallets))
+	res, err = s.ListWallets(ctx, &proto.ListWalletsRequest{Desc: true, PageSize: 2, PageToken: res.NextPageToken})
+	require.NoError(t, err)
+	require.Equal(t, []string{"ccc", "abc"}, names(res.Wallets))
+
+	// Отбор
+	res, err = s.ListWallets(ctx, &proto.ListWalletsRequest{NamePrefix: "ab", RewardMethod: "PPLNS", Sort: proto.WalletSortField_WALLET_SORT_NAME})
+	require.NoError(t, err)
+	require.Equal(t, []string{"ab_", "abc"}, names(res.Wallets))
+	require.Empty(t, res.NextPageToken)
+
+	zero := int64(0)
+	res, err = s.ListWallets(ctx, &proto.ListWalletsRequest{CoinId: 4, MaxHashrate: &zero, CreatedTo: page1.Wallets[0].CreatedAt - 1})
+	require.NoError(t, err)
+	require.Empty(t, res.Wallets)
+
+	// Курсор от другой сортировки
+	_, err = s.ListWallets(ctx, &proto.ListWalletsRequest{PageToken: page1.NextPageToken})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ListWallets(ctx, &proto.ListWalletsRequest{PageToken: "garbage"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ListWallets(ctx, &proto.ListWalletsRequest{RewardMethod: "UNKNOWN"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCServerListWorkersByWallet(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	for _, worker := range []string{"rig1", "rig2", "rig3"} {
+		_, err := s.CreateWorker(ctx, &proto.CreateWorkerRequest{
+			CoinId: 4, Workerfull: "wallet." + worker, Wallet: "wallet", Worker: worker, ServerId: "ALPH-1", RewardMethod: "PPLNS",
+		})
+		require.NoError(t, err)
+	}
+	_, err := s.CreateWorker(ctx, &proto.CreateWorkerRequest{
+		CoinId: 4, Workerfull: "wallet.solo", Wallet: "wallet", Worker: "solo", ServerId: "ALPH-1", RewardMethod: "SOLO",
+	})
+	require.NoError(t, err)
+
+	req := &proto.ListWorkersByWalletRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS", PageSize: 2}
+	page1, err := s.ListWorkersByWallet(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, page1.Workers, 2)
+	require.Equal(t, "rig1", page1.Workers[0].Worker)
+	require.Equal(t, "ALPH-1", page1.Workers[0].ServerId)
+	require.False(t, page1.Workers[0].IsConnect)
+	require.Equal(t, "0.0000000000", page1.Workers[0].CurrentDiff.GetValue())
+	require.NotEmpty(t, page1.NextPageToken)
+
+	req.PageToken = page1.NextPageToken
+	page2, err := s.ListWorkersByWallet(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, page2.Workers, 1)
+	require.Equal(t, "rig3", page2.Workers[0].Worker)
+	require.Empty(t, page2.NextPageToken)
+
+	// Отбор по состоянию подключения (новые воркеры не подключены)
+	res, err := s.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS", Status: proto.WorkerStatusFilter_WORKER_STATUS_ONLINE})
+	require.NoError(t, err)
+	require.Empty(t, res.Workers)
+
+	res, err = s.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS", Status: proto.WorkerStatusFilter_WORKER_STATUS_OFFLINE})
+	require.NoError(t, err)
+	require.Len(t, res.Workers, 3)
+
+	res, err = s.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{Wallet: "unknown", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Empty(t, res.Workers)
+
+	_, err = s.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS", PageToken: "garbage"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCServerUpdateWorkerStats(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	ids := make([]int64, 0)
+	for _, worker := range []string{"rig1", "rig2"} {
+		res, err := s.CreateWorker(ctx, &proto.CreateWorkerRequest{
+			CoinId: 4, Workerfull: "wallet." + worker, Wallet: "wallet", Worker: worker, ServerId: "ALPH-1", RewardMethod: "PPLNS",
+		})
+		require.NoError(t, err)
+		ids = append(ids, res.Id)
+	}
+	_, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+
+	lastShare := int64(1700000000000)
+	reported := int64(110)
+	res, err := s.UpdateWorkerStats(ctx, &proto.UpdateWorkerStatsRequest{Stats: []*proto.WorkerStats{
+		{WorkerId: ids[0], IsConnect: true, CurrentHashrate: 100, AverageHashrate: 90, LastShareDate: lastShare, CurrentDiff: &proto.Decimal{Value: "1.5"}, ReportedHashrate: &reported},
+		{WorkerId: ids[1], IsConnect: true, CurrentHashrate: 50, AverageHashrate: 60},
+		{WorkerId: ids[1], IsConnect: false, CurrentHashrate: 0, AverageHashrate: 40}, // последнее состояние приоритетнее
+		{WorkerId: 999, IsConnect: true, CurrentHashrate: 10},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), res.Updated)
+
+	workers, err := s.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS", Status: proto.WorkerStatusFilter_WORKER_STATUS_ONLINE})
+	require.NoError(t, err)
+	require.Len(t, workers.Workers, 1)
+	require.Equal(t, int64(100), workers.Workers[0].CurrentHashrate)
+	require.Equal(t, lastShare, workers.Workers[0].LastShareDate)
+	require.Equal(t, "1.5000000000", workers.Workers[0].CurrentDiff.GetValue())
+	require.Equal(t, reported, workers.Workers[0].ReportedHashrate)
+	require.NotZero(t, workers.Workers[0].ReportedHashrateDate)
+
+	// сводка по кошельку
+	wallets, err := s.ListWallets(ctx, &proto.ListWalletsRequest{CoinId: 4, NamePrefix: "wallet"})
+	require.NoError(t, err)
+	require.Len(t, wallets.Wallets, 1)
+	require.Equal(t, int64(100), wallets.Wallets[0].CurrentHashrate)
+	require.Equal(t, int64(130), wallets.Wallets[0].AverageHashrate)
+
+	// ограничение размера пакета
+	_, err = s.UpdateWorkerStats(ctx, &proto.UpdateWorkerStatsRequest{Stats: make([]*proto.WorkerStats, constants.UpdateWorkerStatsMaxBatch+1)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCServerPaymentThreshold(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	_, err := s.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: &proto.Coin{Id: 4, Symbol: "ALPH", MinWithdraw: &proto.Decimal{Value: "1.5"}, MaxPaymentThreshold: &proto.Decimal{Value: "1000"}}})
+	require.NoError(t, err)
+	wallet, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+
+	// порог не задан - выплата от минимальной суммы
+	got, err := s.GetPaymentThreshold(ctx, &proto.GetPaymentThresholdRequest{WalletId: wallet.Id})
+	require.NoError(t, err)
+	require.Equal(t, "0.000000", got.PaymentThreshold.GetValue())
+	require.Equal(t, "1.5", got.EffectivePaymentThreshold.GetValue())
+	require.Equal(t, "1000", got.MaxPaymentThreshold.GetValue())
+
+	set, err := s.SetPaymentThreshold(ctx, &proto.SetPaymentThresholdRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "10"}, Actor: "user1"})
+	require.NoError(t, err)
+	require.True(t, set.Changed)
+	require.Equal(t, "10.000000", set.EffectivePaymentThreshold.GetValue())
+
+	// то же значение - без записи в журнал
+	set, err = s.SetPaymentThreshold(ctx, &proto.SetPaymentThresholdRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "10.0"}})
+	require.NoError(t, err)
+	require.False(t, set.Changed)
+
+	// вне диапазона монеты
+	for _, value := range []string{"1", "1000.5"} {
+		_, err = s.SetPaymentThreshold(ctx, &proto.SetPaymentThresholdRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: value}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	_, err = s.SetPaymentThreshold(ctx, &proto.SetPaymentThresholdRequest{WalletId: 999, PaymentThreshold: &proto.Decimal{Value: "10"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// значение в карточке кошелька
+	w, err := s.GetWallet(ctx, &proto.GetWalletRequest{Id: wallet.Id})
+	require.NoError(t, err)
+	require.Equal(t, "10.000000", w.Wallet.PaymentThreshold.GetValue())
+
+	changes, err := s.ListPaymentThresholdChanges(ctx, &proto.ListPaymentThresholdChangesRequest{WalletId: wallet.Id})
+	require.NoError(t, err)
+	require.Len(t, changes.Changes, 1)
+	require.Equal(t, "0.000000", changes.Changes[0].OldValue.GetValue())
+	require.Equal(t, "10.000000", changes.Changes[0].NewValue.GetValue())
+	require.Equal(t, "user1", changes.Changes[0].Actor)
+	require.NotEmpty(t, changes.Changes[0].Service)
+}
+
+func TestGRPCServerSettingsChange(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	_, err := s.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: &proto.Coin{Id: 4, Symbol: "ALPH", MinWithdraw: &proto.Decimal{Value: "1.5"}, MaxPaymentThreshold: &proto.Decimal{Value: "1000"}}})
+	require.NoError(t, err)
+	wallet, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+
+	// значение проверяется при запросе
+	_, err = s.RequestSettingsChange(ctx, &proto.RequestSettingsChangeRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "1"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.RequestSettingsChange(ctx, &proto.RequestSettingsChangeRequest{WalletId: 999, PaymentThreshold: &proto.Decimal{Value: "10"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// новый запрос отменяет предыдущий
+	first, err := s.RequestSettingsChange(ctx, &proto.RequestSettingsChangeRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "10"}})
+	require.NoError(t, err)
+	second, err := s.RequestSettingsChange(ctx, &proto.RequestSettingsChangeRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "20"}, Actor: "user1"})
+	require.NoError(t, err)
+	require.NotEqual(t, first.ConfirmationToken, second.ConfirmationToken)
+	require.Greater(t, second.ExpiresAt, time.Now().UnixMilli())
+
+	// до подтверждения порог не меняется
+	got, err := s.GetPaymentThreshold(ctx, &proto.GetPaymentThresholdRequest{WalletId: wallet.Id})
+	require.NoError(t, err)
+	require.Equal(t, "0.000000", got.PaymentThreshold.GetValue())
+
+	_, err = s.ConfirmSettingsChange(ctx, &proto.ConfirmSettingsChangeRequest{Token: first.ConfirmationToken})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	confirmed, err := s.ConfirmSettingsChange(ctx, &proto.ConfirmSettingsChangeRequest{Token: second.ConfirmationToken})
+	require.NoError(t, err)
+	require.Equal(t, wallet.Id, confirmed.WalletId)
+	require.Equal(t, "20.000000", confirmed.PaymentThreshold.GetValue())
+
+	// токен одноразовый
+	_, err = s.ConfirmSettingsChange(ctx, &proto.ConfirmSettingsChangeRequest{Token: second.ConfirmationToken})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	changes, err := s.ListPaymentThresholdChanges(ctx, &proto.ListPaymentThresholdChangesRequest{WalletId: wallet.Id})
+	require.NoError(t, err)
+	require.Len(t, changes.Changes, 1)
+	require.Equal(t, "20.000000", changes.Changes[0].NewValue.GetValue())
+	require.Equal(t, "user1", changes.Changes[0].Actor)
+
+	// ограничения монеты изменились после запроса
+	pending, err := s.RequestSettingsChange(ctx, &proto.RequestSettingsChangeRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "500"}})
+	require.NoError(t, err)
+	_, err = s.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: &proto.Coin{Id: 4, Symbol: "ALPH", MinWithdraw: &proto.Decimal{Value: "1.5"}, MaxPaymentThreshold: &proto.Decimal{Value: "100"}}})
+	require.NoError(t, err)
+	_, err = s.ConfirmSettingsChange(ctx, &proto.ConfirmSettingsChangeRequest{Token: pending.ConfirmationToken})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGRPCServerDeleteAndMerge(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	_, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	ids := make(map[string]int64)
+	for _, worker := range []string{"rig1", "rgi1", "rig2"} {
+		res, err := s.CreateWorker(ctx, &proto.CreateWorkerRequest{
+			CoinId: 4, Workerfull: "wallet." + worker, Wallet: "wallet", Worker: worker, ServerId: "ALPH-1", Ip: "10.0.0.1", RewardMethod: "PPLNS",
+		})
+		require.NoError(t, err)
+		ids[worker] = res.Id
+	}
+	_, err = s.UpdateWorkerStats(ctx, &proto.UpdateWorkerStatsRequest{Stats: []*proto.WorkerStats{
+		{WorkerId: ids["rig1"], IsConnect: true, CurrentHashrate: 100, AverageHashrate: 100},
+		{WorkerId: ids["rgi1"], IsConnect: true, CurrentHashrate: 20, AverageHashrate: 20},
+		{WorkerId: ids["rig2"], IsConnect: true, CurrentHashrate: 50, AverageHashrate: 50},
+	}})
+	require.NoError(t, err)
+
+	// воркер с опечаткой переносится в правильный
+	_, err = s.MergeWorkers(ctx, &proto.MergeWorkersRequest{SourceId: ids["rgi1"], TargetId: ids["rig1"]})
+	require.NoError(t, err)
+
+	found, err := s.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{Workerfull: "wallet.rgi1", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Zero(t, found.Id)
+	_, err = s.GetWorkerIPHistory(ctx, &proto.GetWorkerIPHistoryRequest{WorkerId: ids["rgi1"]})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	workers, err := s.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Len(t, workers.Workers, 2)
+	require.Equal(t, ids["rig1"], workers.Workers[0].Id)
+	require.Equal(t, int64(120), workers.Workers[0].CurrentHashrate)
+
+	_, err = s.MergeWorkers(ctx, &proto.MergeWorkersRequest{SourceId: ids["rig1"], TargetId: ids["rig1"]})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.MergeWorkers(ctx, &proto.MergeWorkersRequest{SourceId: ids["rgi1"], TargetId: ids["rig1"]})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// объединение воркеров разных кошельков запрещено
+	other, err := s.CreateWorker(ctx, &proto.CreateWorkerRequest{
+		CoinId: 4, Workerfull: "other.rig1", Wallet: "other", Worker: "rig1", ServerId: "ALPH-1", RewardMethod: "PPLNS",
+	})
+	require.NoError(t, err)
+	_, err = s.MergeWorkers(ctx, &proto.MergeWorkersRequest{SourceId: other.Id, TargetId: ids["rig1"]})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// хешрейт кошелька пересчитывается без удаленного воркера
+	_, err = s.DeleteWorker(ctx, &proto.DeleteWorkerRequest{Id: ids["rig2"]})
+	require.NoError(t, err)
+	wallets, err := s.ListWallets(ctx, &proto.ListWalletsRequest{CoinId: 4, NamePrefix: "wallet"})
+	require.NoError(t, err)
+	require.Len(t, wallets.Wallets, 1)
+	require.Equal(t, int64(120), wallets.Wallets[0].CurrentHashrate)
+
+	_, err = s.DeleteWorker(ctx, &proto.DeleteWorkerRequest{Id: ids["rig2"]})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// повторное подключение удаленного воркера создает новую запись
+	again, err := s.CreateWorker(ctx, &proto.CreateWorkerRequest{
+		CoinId: 4, Workerfull: "wallet.rig2", Wallet: "wallet", Worker: "rig2", ServerId: "ALPH-1", RewardMethod: "PPLNS",
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, ids["rig2"], again.Id)
+
+	// кошелек удаляется вместе с воркерами
+	deleted, err := s.DeleteWallet(ctx, &proto.DeleteWalletRequest{Id: wallets.Wallets[0].Id})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), deleted.WorkersDeleted)
+
+	wallet, err := s.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Zero(t, wallet.Id)
+	_, err = s.GetWallet(ctx, &proto.GetWalletRequest{Id: wallets.Wallets[0].Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	workers, err = s.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Empty(t, workers.Workers)
+
+	_, err = s.DeleteWallet(ctx, &proto.DeleteWalletRequest{Id: wallets.Wallets[0].Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// recordingForwarder запоминает переданные шары
+type recordingForwarder struct {
+	shares []entity.Share
+	err    error
+}
+
+func (f *recordingForwarder) ForwardShares(ctx context.Context, shares []entity.Share) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.shares = append(f.shares, shares...)
+	return nil
+}
+
+func TestGRPCServerSubmitShares(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	share := func(uuid, coin, worker, nonce string) *proto.SubmittedShare {
+		return &proto.SubmittedShare{
+			Miner: &proto.MinerIdentity{Coin: coin, Wallet: "wallet", Workerfull: "wallet." + worker, ServerId: "ALPH-1", RewardMethod: "PPLNS"},
+			Uuid:  uuid, ShareDate: 1700000000000, Difficulty: &proto.Decimal{Value: "1024"}, Sharedif: &proto.Decimal{Value: "2048.5"}, Nonce: nonce,
+		}
+	}
+	req := &proto.SubmitSharesRequest{Shares: []*proto.SubmittedShare{
+		share("u1", "ALPH", "rig1", "n1"),
+		share("u1", "ALPH", "rig1", "n2"), // повтор UUID
+		share("u2", "ALPH", "rig1", "n1"), // повтор nonce воркера
+		share("u3", "ALPH", "rig2", "n1"), // тот же nonce у другого воркера
+		share("u4", "NONAME", "rig1", "n3"),
+	}}
+
+	// сервис процессинга шар не настроен
+	_, err := s.SubmitShares(ctx, req)
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	forwarder := &recordingForwarder{}
+	s.shares = forwarder
+	res, err := s.SubmitShares(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []proto.ShareStatus{
+		proto.ShareStatus_SHARE_STATUS_ACCEPTED,
+		proto.ShareStatus_SHARE_STATUS_DUPLICATE,
+		proto.ShareStatus_SHARE_STATUS_DUPLICATE,
+		proto.ShareStatus_SHARE_STATUS_ACCEPTED,
+		proto.ShareStatus_SHARE_STATUS_UNKNOWN_COIN,
+	}, res.Statuses)
+	require.Equal(t, int32(2), res.Accepted)
+	require.Equal(t, int32(2), res.Duplicates)
+
+	// шары дополнены ID кошелька и воркера
+	require.Len(t, forwarder.shares, 2)
+	worker, err := s.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{Workerfull: "wallet.rig2", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	wallet, err := s.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, entity.Share{
+		UUID: "u3", ServerID: "ALPH-1", CoinID: 4, WorkerID: worker.Id, WalletID: wallet.Id,
+		ShareDate: "1700000000000", Difficulty: entity.MustParseDecimal("1024"), Sharedif: entity.MustParseDecimal("2048.5"),
+		Nonce: "n1", RewardMethod: entity.RewardMethodPPLNS,
+	}, forwarder.shares[1])
+
+	// ошибка передачи - пакет можно отправить повторно
+	forwarder.err = errors.New("connection refused")
+	_, err = s.SubmitShares(ctx, req)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = s.SubmitShares(ctx, &proto.SubmitSharesRequest{Shares: make([]*proto.SubmittedShare, constants.SubmitSharesMaxBatch+1)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// некорректная сложность - запрос отклоняется целиком
+	forwarder.err = nil
+	bad := &proto.SubmitSharesRequest{Shares: []*proto.SubmittedShare{share("u9", "ALPH", "rig9", "n9")}}
+	bad.Shares[0].Sharedif = &proto.Decimal{Value: "1e3"}
+	_, err = s.SubmitShares(ctx, bad)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCServerSubmitSharesCost(t *testing.T) {
+	ctx := context.Background()
+	coins := memory.NewCoinRepository(map[string]int64{"ALPH": 4})
+	rewardMethods := memory.NewRewardMethodRepository(map[int64][]entity.RewardMethod{4: entity.RewardMethods})
+	forwarder := &recordingForwarder{}
+	d := memoryDependencies(coins, memory.NewWalletRepository(), memory.NewWorkerRepository(), rewardMethods)
+	d.Shares = forwarder
+	d.Rewards = reward.NewDefaultRegistry()
+	d.RewardParams = reward.ParamsByCoin{Coins: map[string]reward.Params{"ALPH": {HashesPerDiff: entity.MustParseDecimal("1000000000")}}}
+	s, err := NewGRPCServer(d)
+	require.NoError(t, err)
+
+	rate := entity.MustParseDecimal("0.5")
+	_, err = coins.PatchCoinParams(ctx, 4, entity.CoinParamsPatch{CurrentRewardPerGigahashPPS: &rate})
+	require.NoError(t, err)
+
+	share := func(uuid, rewardMethod string) *proto.SubmittedShare {
+		return &proto.SubmittedShare{
+			Miner: &proto.MinerIdentity{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig1", ServerId: "ALPH-1", RewardMethod: rewardMethod},
+			Uuid:  uuid, ShareDate: 1700000000000, Difficulty: &proto.Decimal{Value: "1024"}, Sharedif: &proto.Decimal{Value: "86400"}, Nonce: uuid,
+		}
+	}
+	res, err := s.SubmitShares(ctx, &proto.SubmitSharesRequest{Shares: []*proto.SubmittedShare{share("u1", "PPS"), share("u2", "PPLNS")}})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), res.Accepted)
+
+	// PPS - по ставке монеты, PPLNS без сложности раунда не рассчитывается (шара передается без стоимости)
+	require.Len(t, forwarder.shares, 2)
+	require.NotNil(t, forwarder.shares[0].Cost)
+	require.Equal(t, "0.500000000000000000", forwarder.shares[0].Cost.String())
+	require.Nil(t, forwarder.shares[1].Cost)
+}
+
+func TestGRPCServerSubmitSharesDuplicates(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	forwarder := &recordingForwarder{}
+	s.shares = forwarder
+	s.duplicates = dedup.NewDetector(memory.NewShareKeyRepository(), time.Hour)
+
+	share := func(uuid, serverID, nonce string) *proto.SubmittedShare {
+		return &proto.SubmittedShare{
+			Miner: &proto.MinerIdentity{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig1", ServerId: serverID, RewardMethod: "PPLNS"},
+			Uuid:  uuid, ShareDate: 1700000000000, Difficulty: &proto.Decimal{Value: "1024"}, Sharedif: &proto.Decimal{Value: "2048.5"}, Nonce: nonce,
+		}
+	}
+
+	// не переданные шары не запоминаются - повторная отправка пакета принимается
+	forwarder.err = errors.New("connection refused")
+	_, err := s.SubmitShares(ctx, &proto.SubmitSharesRequest{Shares: []*proto.SubmittedShare{share("u1", "ALPH-1", "n1")}})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	forwarder.err = nil
+
+	res, err := s.SubmitShares(ctx, &proto.SubmitSharesRequest{Shares: []*proto.SubmittedShare{share("u1", "ALPH-1", "n1")}})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), res.Accepted)
+
+	// после переподключения пул-сервер присылает шары повторно
+	res, err = s.SubmitShares(ctx, &proto.SubmitSharesRequest{Shares: []*proto.SubmittedShare{
+		share("u1", "ALPH-1", "n9"), // повтор UUID
+		share("u2", "ALPH-2", "n1"), // повтор nonce воркера с другого пул-сервера
+		share("u3", "ALPH-2", "n3"),
+		share("u3", "ALPH-2", "n3"), // повтор внутри запроса
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []proto.ShareStatus{
+		proto.ShareStatus_SHARE_STATUS_DUPLICATE,
+		proto.ShareStatus_SHARE_STATUS_DUPLICATE,
+		proto.ShareStatus_SHARE_STATUS_ACCEPTED,
+		proto.ShareStatus_SHARE_STATUS_DUPLICATE,
+	}, res.Statuses)
+	require.Len(t, forwarder.shares, 2)
+	require.Equal(t, "u3", forwarder.shares[1].UUID)
+
+	stats, err := s.GetShareDuplicateStats(ctx, &proto.GetShareDuplicateStatsRequest{})
+	require.NoError(t, err)
+	require.Len(t, stats.Servers, 2)
+	require.Equal(t, "ALPH-1", stats.Servers[0].ServerId)
+	require.Equal(t, uint64(2), stats.Servers[0].Shares)
+	require.Equal(t, uint64(1), stats.Servers[0].Duplicates)
+	require.Equal(t, "ALPH-2", stats.Servers[1].ServerId)
+	require.Equal(t, uint64(3), stats.Servers[1].Shares)
+	require.Equal(t, uint64(2), stats.Servers[1].Duplicates)
+	require.InDelta(t, 2.0/3, stats.Servers[1].DuplicateRate, 1e-9)
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	protov2 "github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto/v2"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// GRPCServerV2 версия 2 сервиса MinersService с единой моделью ошибок:
+// отсутствующая запись - codes.NotFound, некорректный запрос - codes.InvalidArgument (оба с деталями MPError).
+// Версия 1 (GRPCServer) ради совместимости со старыми клиентами сохраняет id = 0 для отсутствующих кошельков и воркеров
+// и codes.Internal для остальных ошибок (в том числе для неизвестной монеты).
+// Запросы версии 2 дополнительно проверяет интерсептор validation.Validator, читающие методы версии 1 из LegacyMethods он пропускает
+type GRPCServerV2 struct {
+	protov2.UnimplementedMinersServiceServer
+	v1 *GRPCServer
+}
+
+// LegacyMethods читающие методы версии 1, появившиеся до версии 2: их поведение для старых клиентов не меняется,
+// поэтому интерсептор validation.Validator их запросы не проверяет.
+// Методы, создающие записи (CreateWallet, CreateWorker, ResolveMiners, StreamResolveMiners), проверяются всегда -
+// иначе в базу попадали бы имена длиннее колонок и воркеры, не принадлежащие кошельку
+var LegacyMethods = []string{
+	proto.MinersService_GetCoinIDByName_FullMethodName,
+	proto.MinersService_GetWalletIDByName_FullMethodName,
+	proto.MinersService_GetWorkerIDByName_FullMethodName,
+}
+
+func NewGRPCServerV2(v1 *GRPCServer) (*GRPCServerV2, error) {
+	s := &GRPCServerV2{
+		v1: v1,
+	}
+
+	return s, nil
+}
+
+func (s *GRPCServerV2) GetCoinIDByName(ctx context.Context, req *proto.GetCoinIDByNameRequest) (*proto.GetCoinIDByNameResponse, error) {
+	if req.Coin == "" {
+		return nil, invalidArgument("GetCoinIDByName", "coin is required")
+	}
+
+	return s.v1.getCoinIDByName(ctx, req, statusError)
+}
+
+func (s *GRPCServerV2) CreateWallet(ctx context.Context, req *proto.CreateWalletRequest) (*proto.CreateWalletResponse, error) {
+	if req.CoinId <= 0 {
+		return nil, invalidArgument("CreateWallet", "coin_id is required")
+	}
+	if req.Name == "" {
+		return nil, invalidArgument("CreateWallet", "name is required")
+	}
+
+	return s.v1.createWallet(ctx, req, statusError)
+}
+
+func (s *GRPCServerV2) CreateWorker(ctx context.Context, req *proto.CreateWorkerRequest) (*proto.CreateWorkerResponse, error) {
+	if req.CoinId <= 0 {
+		return nil, invalidArgument("CreateWorker", "coin_id is required")
+	}
+	if req.Workerfull == "" {
+		return nil, invalidArgument("CreateWorker", "workerfull is required")
+	}
+	if req.Wallet == "" {
+		return nil, invalidArgument("CreateWorker", "wallet is required")
+	}
+
+	return s.v1.createWorker(ctx, req, statusError)
+}
+
+func (s *GRPCServerV2) GetWalletIDByName(ctx context.Context, req *proto.GetWalletIDByNameRequest) (*proto.GetWalletIDByNameResponse, error) {
+	if req.CoinId <= 0 {
+		return nil, invalidArgument("GetWalletIDByName", "coin_id is required")
+	}
+	if req.Wallet == "" {
+		return nil, invalidArgument("GetWalletIDByName", "wallet is required")
+	}
+
+	wallet, err := s.v1.wallets.GetWalletByName(ctx, req.Wallet, req.CoinId, entity.RewardMethod(req.RewardMethod))
+	if err != nil {
+		return nil, statusError("GetWalletIDByName", err)
+	}
+
+	return &proto.GetWalletIDByNameResponse{Id: wallet.ID}, nil
+}
+
+func (s *GRPCServerV2) GetWorkerIDByName(ctx context.Context, req *proto.GetWorkerIDByNameRequest) (*proto.GetWorkerIDByNameResponse, error) {
+	if req.CoinId <= 0 {
+		return nil, invalidArgument("GetWorkerIDByName", "coin_id is required")
+	}
+	if req.Workerfull == "" {
+		return nil, invalidArgument("GetWorkerIDByName", "workerfull is required")
+	}
+
+	worker, err := s.v1.workers.GetWorkerByName(ctx, req.Workerfull, req.CoinId, entity.RewardMethod(req.RewardMethod))
+	if err != nil {
+		return nil, statusError("GetWorkerIDByName", err)
+	}
+
+	return &proto.GetWorkerIDByNameResponse{Id: worker.ID}, nil
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+// requireStatus проверка кода ошибки и наличия деталей MPError
+func requireStatus(t *testing.T, err error, code codes.Code, method string) {
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, code, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	detail, ok := st.Details()[0].(*proto.MPError)
+	require.True(t, ok)
+	require.Equal(t, method, detail.Method)
+}
+
+func TestGRPCServerV2(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewGRPCServerV2(newTestServer(t))
+	require.NoError(t, err)
+
+	// Coin
+	coin, err := s.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "ALPH"})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), coin.Id)
+
+	_, err = s.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "NONAME"})
+	requireStatus(t, err, codes.NotFound, "GetCoinIDByName")
+
+	_, err = s.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{})
+	requireStatus(t, err, codes.InvalidArgument, "GetCoinIDByName")
+
+	// Wallet
+	_, err = s.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	requireStatus(t, err, codes.NotFound, "GetWalletIDByName")
+
+	_, err = s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, RewardMethod: "PPLNS"})
+	requireStatus(t, err, codes.InvalidArgument, "CreateWallet")
+
+	res, err := s.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+
+	wallet, err := s.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, res.Id, wallet.Id)
+
+	// Worker
+	_, err = s.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{Workerfull: "wallet.worker", CoinId: 4, RewardMethod: "PPLNS"})
+	requireStatus(t, err, codes.NotFound, "GetWorkerIDByName")
+
+	_, err = s.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{Workerfull: "wallet.worker", RewardMethod: "PPLNS"})
+	requireStatus(t, err, codes.InvalidArgument, "GetWorkerIDByName")
+
+	res3, err := s.CreateWorker(ctx, &proto.CreateWorkerRequest{
+		CoinId:       4,
+		Workerfull:   "wallet.worker",
+		Wallet:       "wallet",
+		Worker:       "worker",
+		ServerId:     "SERV",
+		RewardMethod: "PPLNS",
+	})
+	require.NoError(t, err)
+
+	worker, err := s.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{Workerfull: "wallet.worker", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, res3.Id, worker.Id)
+}
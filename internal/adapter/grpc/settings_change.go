@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// RequestSettingsChange запрос майнера на изменение порога выплаты
+// значение проверяется сразу, но применяется только ConfirmSettingsChange с выданным токеном
+// (знания адреса кошелька недостаточно - токен получает только доверенный сервис доставки из RelayMethods
+// и передает его майнеру по подтвержденному каналу, инициатору изменения токен не возвращается)
+func (s *GRPCServer) RequestSettingsChange(ctx context.Context, req *proto.RequestSettingsChangeRequest) (*proto.RequestSettingsChangeResponse, error) {
+	_, coin, err := s.walletWithCoin(ctx, req.WalletId)
+	if err != nil {
+		return nil, statusError("RequestSettingsChange", err)
+	}
+	threshold, err := paymentThresholdFromProto(req.PaymentThreshold, coin)
+	if err != nil {
+		return nil, invalidArgument("RequestSettingsChange", "payment_threshold: "+err.Error())
+	}
+
+	token, tokenHash, err := entity.NewConfirmationToken()
+	if err != nil {
+		return nil, statusError("RequestSettingsChange", err)
+	}
+
+	expiresAt := time.Now().Add(constants.SettingsChangeTTL * time.Minute)
+	id, err := s.settingsChanges.CreateSettingsChange(ctx, entity.SettingsChange{
+		WalletID:  req.WalletId,
+		Kind:      entity.SettingsKindPaymentThreshold,
+		Value:     threshold.String(),
+		TokenHash: tokenHash,
+		Service:   callerName(ctx),
+		Actor:     req.Actor,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, statusError("RequestSettingsChange", err)
+	}
+
+	return &proto.RequestSettingsChangeResponse{
+		ChangeId:          id,
+		ConfirmationToken: token,
+		ExpiresAt:         expiresAt.UnixMilli(),
+	}, nil
+}
+
+// ConfirmSettingsChange применение изменения по одноразовому токену
+// неизвестный, использованный, замененный новым запросом или истекший токен - codes.NotFound,
+// значение вне изменившихся с момента запроса ограничений монеты - codes.FailedPrecondition
+func (s *GRPCServer) ConfirmSettingsChange(ctx context.Context, req *proto.ConfirmSettingsChangeRequest) (*proto.ConfirmSettingsChangeResponse, error) {
+	change, err := s.settingsChanges.ConfirmSettingsChange(ctx, entity.HashConfirmationToken(req.Token), callerName(ctx))
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, statusWithDetail(codes.NotFound, "ConfirmSettingsChange", "confirmation token is invalid or expired")
+	case errors.Is(err, entity.ErrPaymentThresholdOutOfRange):
+		return nil, statusWithDetail(codes.FailedPrecondition, "ConfirmSettingsChange", "payment_threshold: "+err.Error())
+	case err != nil:
+		return nil, statusError("ConfirmSettingsChange", err)
+	}
+
+	threshold, err := entity.ParseDecimal(change.Value)
+	if err != nil {
+		return nil, internalError("ConfirmSettingsChange", err)
+	}
+
+	return &proto.ConfirmSettingsChangeResponse{
+		WalletId:         change.WalletID,
+		PaymentThreshold: decimalToProto(threshold),
+	}, nil
+}
@@ -0,0 +1,253 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/dnsoftware/mpm-save-get-shares/pkg/logger"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/dedup"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+	"github.com/dnsoftware/mpm-miners-processor/internal/reward"
+)
+
+// SharesForwarder передача шар сервису процессинга шар
+type SharesForwarder interface {
+	// ForwardShares передача пакета шар с заполненными ID монеты, кошелька и воркера
+	ForwardShares(ctx context.Context, shares []entity.Share) error
+}
+
+// shareNonceKey повтор nonce определяется в пределах воркера монеты
+type shareNonceKey struct {
+	coinID   int64
+	workerID int64
+	nonce    string
+}
+
+// SubmitShares прием пакета шар: недостающие кошельки и воркеры создаются (как в ResolveMiners),
+// повторы UUID и nonce воркера (внутри запроса и среди ранее принятых шар) отбрасываются,
+// у остальных шар рассчитывается стоимость по методу начисления, и они передаются сервису процессинга шар одним пакетом
+// ошибка проверки повторов или передачи - codes.Unavailable (пакет можно отправить повторно целиком)
+func (s *GRPCServer) SubmitShares(ctx context.Context, req *proto.SubmitSharesRequest) (*proto.SubmitSharesResponse, error) {
+	if s.shares == nil {
+		return nil, statusWithDetail(codes.Unimplemented, "SubmitShares", "shares processor is not configured")
+	}
+	if len(req.Shares) > constants.SubmitSharesMaxBatch {
+		return nil, invalidArgument("SubmitShares", fmt.Sprintf("too many shares in request: %d, max %d", len(req.Shares), constants.SubmitSharesMaxBatch))
+	}
+
+	// сложности разбираются до создания кошельков и воркеров - некорректный запрос не должен ничего менять
+	difficulties := make([]entity.Decimal, len(req.Shares))
+	sharedifs := make([]entity.Decimal, len(req.Shares))
+	for i, sh := range req.Shares {
+		var err error
+		if difficulties[i], err = decimalFromProto(sh.GetDifficulty()); err != nil {
+			return nil, invalidArgument("SubmitShares", fmt.Sprintf("shares[%d].difficulty: %v", i, err))
+		}
+		if sharedifs[i], err = decimalFromProto(sh.GetSharedif()); err != nil {
+			return nil, invalidArgument("SubmitShares", fmt.Sprintf("shares[%d].sharedif: %v", i, err))
+		}
+	}
+
+	resp := &proto.SubmitSharesResponse{
+		Statuses: make([]proto.ShareStatus, len(req.Shares)),
+	}
+
+	// повторы UUID не разрешаются (кошельки и воркеры для них не создаются)
+	seenUUIDs := make(map[string]struct{}, len(req.Shares))
+	identities := make([]*proto.MinerIdentity, 0, len(req.Shares))
+	positions := make([]int, 0, len(req.Shares)) // индекс шары в запросе для каждого элемента identities
+	for i, sh := range req.Shares {
+		if _, ok := seenUUIDs[sh.Uuid]; ok {
+			resp.Statuses[i] = proto.ShareStatus_SHARE_STATUS_DUPLICATE
+			continue
+		}
+		seenUUIDs[sh.Uuid] = struct{}{}
+		identities = append(identities, sh.Miner)
+		positions = append(positions, i)
+	}
+
+	resolved, err := s.resolveMiners(ctx, identities)
+	if err != nil {
+		return nil, statusError("SubmitShares", err)
+	}
+
+	seenNonces := make(map[shareNonceKey]struct{}, len(resolved))
+	shares := make([]entity.Share, 0, len(resolved))
+	sharePositions := make([]int, 0, len(resolved)) // индекс шары в запросе для каждого элемента shares
+	for j, ids := range resolved {
+		i := positions[j]
+		sh := req.Shares[i]
+		if ids.CoinId == 0 {
+			resp.Statuses[i] = proto.ShareStatus_SHARE_STATUS_UNKNOWN_COIN
+			continue
+		}
+		if ids.WalletId == 0 {
+			resp.Statuses[i] = proto.ShareStatus_SHARE_STATUS_UNSUPPORTED_REWARD_METHOD
+			continue
+		}
+		key := shareNonceKey{coinID: ids.CoinId, workerID: ids.WorkerId, nonce: sh.Nonce}
+		if _, ok := seenNonces[key]; ok {
+			resp.Statuses[i] = proto.ShareStatus_SHARE_STATUS_DUPLICATE
+			continue
+		}
+		seenNonces[key] = struct{}{}
+
+		shares = append(shares, entity.Share{
+			UUID:         sh.Uuid,
+			ServerID:     sh.Miner.ServerId,
+			CoinID:       ids.CoinId,
+			WorkerID:     ids.WorkerId,
+			WalletID:     ids.WalletId,
+			ShareDate:    strconv.FormatInt(sh.ShareDate, 10),
+			Difficulty:   difficulties[i],
+			Sharedif:     sharedifs[i],
+			Nonce:        sh.Nonce,
+			RewardMethod: entity.RewardMethod(sh.Miner.RewardMethod),
+		})
+		sharePositions = append(sharePositions, i)
+	}
+
+	// повторы ранее принятых шар (в пределах окна дедупликации), ключи новых шар занимаются сразу
+	var claim dedup.Claim
+	if s.duplicates != nil && len(shares) > 0 {
+		var err error
+		claim, err = s.duplicates.Claim(ctx, shares)
+		if err != nil {
+			return nil, statusWithDetail(codes.Unavailable, "SubmitShares", "check duplicates: "+err.Error())
+		}
+		fresh := make([]entity.Share, 0, len(shares))
+		freshPositions := make([]int, 0, len(shares))
+		for j, dup := range claim.Duplicates {
+			if dup {
+				resp.Statuses[sharePositions[j]] = proto.ShareStatus_SHARE_STATUS_DUPLICATE
+				continue
+			}
+			fresh = append(fresh, shares[j])
+			freshPositions = append(freshPositions, sharePositions[j])
+		}
+		shares, sharePositions = fresh, freshPositions
+	}
+
+	// стоимость рассчитывается только для шар, прошедших проверку на повтор
+	if s.rewards != nil && len(shares) > 0 {
+		if err := s.calculateCosts(ctx, shares); err != nil {
+			if s.duplicates != nil {
+				if rerr := s.duplicates.Release(context.WithoutCancel(ctx), claim); rerr != nil {
+					logger.Log().Error("SubmitShares: " + rerr.Error())
+				}
+			}
+			return nil, statusError("SubmitShares", err)
+		}
+	}
+
+	if len(shares) > 0 {
+		if err := s.shares.ForwardShares(ctx, shares); err != nil {
+			// шары не переданы - их повторная отправка не должна считаться повтором
+			if s.duplicates != nil {
+				if rerr := s.duplicates.Release(context.WithoutCancel(ctx), claim); rerr != nil {
+					logger.Log().Error("SubmitShares: " + rerr.Error())
+				}
+			}
+			return nil, statusWithDetail(codes.Unavailable, "SubmitShares", "forward shares: "+err.Error())
+		}
+	}
+	for _, i := range sharePositions {
+		resp.Statuses[i] = proto.ShareStatus_SHARE_STATUS_ACCEPTED
+	}
+
+	if s.duplicates != nil {
+		s.observeDuplicates(req.Shares, resp.Statuses)
+	}
+
+	for _, st := range resp.Statuses {
+		switch st {
+		case proto.ShareStatus_SHARE_STATUS_ACCEPTED:
+			resp.Accepted++
+		case proto.ShareStatus_SHARE_STATUS_DUPLICATE:
+			resp.Duplicates++
+		}
+	}
+
+	return resp, nil
+}
+
+// calculateCosts стоимость шар по их методу начисления (монета читается один раз на пакет),
+// шара, стоимость которой не рассчитана (метод без калькулятора, неизвестна сложность), передается без Cost
+func (s *GRPCServer) calculateCosts(ctx context.Context, shares []entity.Share) error {
+	coins := make(map[int64]entity.Coin)
+	logged := make(map[int64]struct{}) // монеты, ошибка расчета по которым уже записана в лог
+	for i, sh := range shares {
+		coin, ok := coins[sh.CoinID]
+		if !ok {
+			var err error
+			if coin, err = s.coins.GetCoin(ctx, sh.CoinID); err != nil {
+				return err
+			}
+			coins[sh.CoinID] = coin
+		}
+
+		costed, err := s.rewards.Calculate(sh, coin, s.rewardParams.For(coin))
+		if err != nil {
+			if _, ok := logged[sh.CoinID]; !ok && !errors.Is(err, reward.ErrUnsupportedRewardMethod) {
+				logged[sh.CoinID] = struct{}{}
+				logger.Log().Warn(fmt.Sprintf("SubmitShares: share cost of coin %s: %v", coin.Symbol, err))
+			}
+			continue
+		}
+		shares[i] = costed
+	}
+
+	return nil
+}
+
+// GetShareDuplicateStats счетчики повторов по пул-серверам этого экземпляра (пустой список, если поиск повторов не настроен),
+// сводные счетчики всех экземпляров - метрики miners_processor_shares_checked_total и miners_processor_share_duplicates_total
+func (s *GRPCServer) GetShareDuplicateStats(ctx context.Context, req *proto.GetShareDuplicateStatsRequest) (*proto.GetShareDuplicateStatsResponse, error) {
+	resp := &proto.GetShareDuplicateStatsResponse{
+		Servers: make([]*proto.ShareDuplicateStats, 0),
+	}
+	if s.duplicates == nil {
+		return resp, nil
+	}
+
+	for serverID, st := range s.duplicates.Stats() {
+		resp.Servers = append(resp.Servers, &proto.ShareDuplicateStats{
+			ServerId:      serverID,
+			Shares:        st.Shares,
+			Duplicates:    st.Duplicates,
+			DuplicateRate: st.DuplicateRate(),
+		})
+	}
+	sort.Slice(resp.Servers, func(i, j int) bool {
+		return resp.Servers[i].ServerId < resp.Servers[j].ServerId
+	})
+
+	return resp, nil
+}
+
+// observeDuplicates учет принятых шар и повторов по пул-серверам (отклоненные до проверки на повтор шары не учитываются)
+func (s *GRPCServer) observeDuplicates(shares []*proto.SubmittedShare, statuses []proto.ShareStatus) {
+	type counters struct{ shares, duplicates int }
+	byServer := make(map[string]counters)
+	for i, st := range statuses {
+		if st == proto.ShareStatus_SHARE_STATUS_UNKNOWN_COIN || st == proto.ShareStatus_SHARE_STATUS_UNSUPPORTED_REWARD_METHOD {
+			continue
+		}
+		c := byServer[shares[i].Miner.ServerId]
+		c.shares++
+		if st == proto.ShareStatus_SHARE_STATUS_DUPLICATE {
+			c.duplicates++
+		}
+		byServer[shares[i].Miner.ServerId] = c
+	}
+	for serverID, c := range byServer {
+		s.duplicates.Observe(serverID, c.shares, c.duplicates)
+	}
+}
@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+)
+
+// StreamResolveMiners потоковое получение ID монет, кошельков и воркеров
+// Входящие запросы набираются в пакеты (не более StreamResolveBatchSize элементов или
+// не дольше StreamResolveFlushInterval с момента прихода первого элемента пакета),
+// каждый пакет обрабатывается одним обращением к БД, ответы отправляются в порядке запросов
+func (s *GRPCServer) StreamResolveMiners(stream proto.MinersService_StreamResolveMinersServer) error {
+	ctx := stream.Context()
+
+	in := make(chan *proto.MinerIdentity, constants.StreamResolveBatchSize)
+	recvErr := make(chan error, 1)
+
+	// Чтение входящего потока в фоне, чтобы набирать пакет, пока обрабатывается предыдущий
+	go func() {
+		defer close(in)
+		for {
+			m, err := stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					recvErr <- err
+				}
+				return
+			}
+
+			select {
+			case in <- m:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	batch := make([]*proto.MinerIdentity, 0, constants.StreamResolveBatchSize)
+	for {
+		// ждем первый элемент пакета
+		m, ok := <-in
+		if !ok {
+			select {
+			case err := <-recvErr:
+				return err
+			default:
+				return nil // клиент закрыл поток
+			}
+		}
+		batch = append(batch[:0], m)
+
+		// добираем пакет
+		flush := time.After(constants.StreamResolveFlushInterval * time.Millisecond)
+	collect:
+		for len(batch) < constants.StreamResolveBatchSize {
+			select {
+			case m, ok := <-in:
+				if !ok {
+					break collect
+				}
+				batch = append(batch, m)
+			case <-flush:
+				break collect
+			}
+		}
+
+		resolved, err := s.resolveMiners(ctx, batch)
+		if err != nil {
+			return internalError("StreamResolveMiners", err)
+		}
+
+		for _, r := range resolved {
+			if err := stream.Send(r); err != nil {
+				return err
+			}
+		}
+	}
+}
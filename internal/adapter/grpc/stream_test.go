@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage/memory"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+	"github.com/dnsoftware/mpm-miners-processor/internal/monitor"
+)
+
+func TestStreamResolveMiners(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	proto.RegisterMinersServiceServer(grpcServer, newTestServer(t))
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := proto.NewMinersServiceClient(conn).StreamResolveMiners(ctx)
+	require.NoError(t, err)
+
+	// больше одного пакета, воркеры повторяются
+	const total = 1200
+	go func() {
+		for i := 0; i < total; i++ {
+			coin := "ALPH"
+			if i%100 == 99 {
+				coin = "NONAME"
+			}
+			err := stream.Send(&proto.MinerIdentity{
+				Coin:         coin,
+				Wallet:       "wallet",
+				Workerfull:   fmt.Sprintf("wallet.rig%d", i%300),
+				ServerId:     "SERV",
+				RewardMethod: "PPLNS",
+			})
+			if err != nil {
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+
+	workerIDs := make(map[int]int64)
+	for i := 0; ; i++ {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			require.Equal(t, total, i)
+			break
+		}
+		require.NoError(t, err)
+
+		if i%100 == 99 {
+			require.Equal(t, int64(0), res.CoinId)
+			continue
+		}
+		require.Equal(t, int64(4), res.CoinId)
+		require.NotZero(t, res.WalletId)
+		require.NotZero(t, res.WorkerId)
+
+		// порядок ответов соответствует порядку запросов
+		if id, ok := workerIDs[i%300]; ok {
+			require.Equal(t, id, res.WorkerId)
+		} else {
+			workerIDs[i%300] = res.WorkerId
+		}
+	}
+	require.Len(t, workerIDs, 297) // rig99, rig199, rig299 приходят только с неизвестной монетой
+}
+
+func TestWatchWorkerStatus(t *testing.T) {
+	coins := memory.NewCoinRepository(map[string]int64{"ALPH": 4})
+	wallets := memory.NewWalletRepository()
+	workers := memory.NewWorkerRepository()
+	events := monitor.NewBroker()
+	deps := memoryDependencies(coins, wallets, workers, memory.NewRewardMethodRepository(map[int64][]entity.RewardMethod{4: entity.RewardMethods}))
+	deps.WorkerStats = monitor.NewPublishingUpdater(deps.WorkerStats, events)
+	deps.WorkerEvents = events
+	s, err := NewGRPCServer(deps)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	proto.RegisterMinersServiceServer(grpcServer, s)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := proto.NewMinersServiceClient(conn)
+
+	ids := make([]int64, 0)
+	for _, wallet := range []string{"other", "wallet"} {
+		res, err := client.CreateWorker(ctx, &proto.CreateWorkerRequest{
+			CoinId: 4, Workerfull: wallet + ".rig", Wallet: wallet, Worker: "rig", ServerId: "ALPH-1", RewardMethod: "PPLNS",
+		})
+		require.NoError(t, err)
+		ids = append(ids, res.Id)
+	}
+
+	stream, err := client.WatchWorkerStatus(ctx, &proto.WatchWorkerStatusRequest{CoinId: 4, Wallet: "wallet"})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return events.Len() == 1 }, time.Second, 10*time.Millisecond)
+
+	_, err = client.UpdateWorkerStats(ctx, &proto.UpdateWorkerStatsRequest{Stats: []*proto.WorkerStats{
+		{WorkerId: ids[0], IsConnect: true},
+		{WorkerId: ids[1], IsConnect: true, LastShareDate: 1700000000000},
+	}})
+	require.NoError(t, err)
+
+	// событие другого кошелька отфильтровано
+	e, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, ids[1], e.WorkerId)
+	require.Equal(t, "wallet.rig", e.Workerfull)
+	require.True(t, e.Online)
+	require.Equal(t, int64(1700000000000), e.LastShareDate)
+	require.NotZero(t, e.Time)
+
+	// остановка сервиса завершает поток
+	events.Close()
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,59 @@
+package validation
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// UnaryServerInterceptor серверный интерсептор проверки запросов
+func (v *Validator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if msg, ok := req.(gproto.Message); ok && !v.skip[info.FullMethod] {
+			if err := v.Validate(ctx, info.FullMethod, msg); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor серверный интерсептор проверки каждого входящего сообщения потока
+func (v *Validator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if v.skip[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		return handler(srv, &validatingServerStream{ServerStream: ss, validator: v, method: info.FullMethod})
+	}
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+	validator *Validator
+	method    string
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if msg, ok := m.(gproto.Message); ok {
+		return s.validator.Validate(s.Context(), s.method, msg)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto/validate"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// Validator проверка запросов по правилам из аннотаций proto (см. proto/validate/validate.proto)
+type Validator struct {
+	coins storage.CoinRepository
+	skip  map[string]bool // полные имена методов, запросы которых интерсепторы не проверяют
+}
+
+// NewValidator skipMethods - полные имена методов, которые интерсепторы пропускают без проверки
+// (методы с поведением, зафиксированным до появления проверок)
+func NewValidator(coins storage.CoinRepository, skipMethods ...string) *Validator {
+	skip := make(map[string]bool, len(skipMethods))
+	for _, m := range skipMethods {
+		skip[m] = true
+	}
+
+	return &Validator{
+		coins: coins,
+		skip:  skip,
+	}
+}
+
+// Validate проверка сообщения msg (включая вложенные сообщения)
+// При нарушении правил возвращается codes.InvalidArgument с деталями BadRequest (список полей) и MPError
+func (v *Validator) Validate(ctx context.Context, method string, msg gproto.Message) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	err := v.validateMessage(ctx, msg.ProtoReflect(), "", &violations)
+	if err != nil {
+		st := status.New(codes.Internal, err.Error())
+		st, _ = st.WithDetails(&proto.MPError{Method: method, Description: "validation error: " + err.Error()})
+		return st.Err()
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(violations))
+	for i, fv := range violations {
+		descriptions[i] = fv.Field + ": " + fv.Description
+	}
+	description := "invalid request: " + strings.Join(descriptions, "; ")
+
+	st := status.New(codes.InvalidArgument, description)
+	if withDetails, err := st.WithDetails(
+		&errdetails.BadRequest{FieldViolations: violations},
+		&proto.MPError{Method: method, Description: description},
+	); err == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+func (v *Validator) validateMessage(ctx context.Context, m protoreflect.Message, prefix string, violations *[]*errdetails.BadRequest_FieldViolation) error {
+	violated := make(map[string]bool) // поля с нарушениями (для них правила сообщения уже не проверяются)
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+
+		// вложенные сообщения
+		if fd.Kind() == protoreflect.MessageKind {
+			if rules, ok := gproto.GetExtension(fd.Options(), validate.E_Rules).(*validate.FieldRules); ok && rules != nil &&
+				rules.Required && !fd.IsList() && !fd.IsMap() && !m.Has(fd) {
+				*violations = append(*violations, &errdetails.BadRequest_FieldViolation{Field: path, Description: "value is required"})
+				continue
+			}
+			if fd.IsList() {
+				list := m.Get(fd).List()
+				for j := 0; j < list.Len(); j++ {
+					if err := v.validateMessage(ctx, list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j), violations); err != nil {
+						return err
+					}
+				}
+			} else if !fd.IsMap() && m.Has(fd) {
+				if err := v.validateMessage(ctx, m.Get(fd).Message(), path+".", violations); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		rules, ok := gproto.GetExtension(fd.Options(), validate.E_Rules).(*validate.FieldRules)
+		if !ok || rules == nil {
+			continue
+		}
+
+		description, err := v.validateField(ctx, fd, m.Get(fd), rules)
+		if err != nil {
+			return err
+		}
+		if description != "" {
+			violated[string(fd.Name())] = true
+			*violations = append(*violations, &errdetails.BadRequest_FieldViolation{Field: path, Description: description})
+		}
+	}
+
+	msgRules, ok := gproto.GetExtension(m.Descriptor().Options(), validate.E_Message).(*validate.MessageRules)
+	if ok && msgRules != nil {
+		for _, join := range msgRules.Join {
+			if violated[join.Field] || containsAny(violated, join.Parts) {
+				continue
+			}
+			if description := validateJoin(m, join); description != "" {
+				*violations = append(*violations, &errdetails.BadRequest_FieldViolation{Field: prefix + join.Field, Description: description})
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateField проверка значения поля, возвращает описание нарушения (пустая строка - нарушений нет)
+func (v *Validator) validateField(ctx context.Context, fd protoreflect.FieldDescriptor, value protoreflect.Value, rules *validate.FieldRules) (string, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		s := value.String()
+		if s == "" && rules.Required {
+			return "value is required", nil
+		}
+		if rules.MaxLen > 0 && utf8.RuneCountInString(s) > int(rules.MaxLen) {
+			return fmt.Sprintf("value length must be at most %d characters", rules.MaxLen), nil
+		}
+		if len(rules.In) > 0 && !contains(rules.In, s) { // пустая строка тоже должна быть в списке
+			return fmt.Sprintf("value must be one of [%s]", strings.Join(rules.In, ", ")), nil
+		}
+		if rules.RewardMethod && !entity.RewardMethod(s).Valid() {
+			return fmt.Sprintf("unknown reward method %q", s), nil
+		}
+		if rules.Decimal && s != "" {
+			if d, err := entity.ParseDecimal(s); err != nil || d.Sign() < 0 {
+				return "value must be a non-negative decimal number", nil
+			}
+		}
+		if rules.Ip && s != "" {
+			if _, err := netip.ParseAddr(s); err != nil {
+				return "value must be an IPv4 or IPv6 address", nil
+			}
+		}
+
+	case protoreflect.Int64Kind, protoreflect.Int32Kind:
+		n := value.Int()
+		if rules.Required && n <= 0 {
+			return "value must be greater than 0", nil
+		}
+		if rules.CoinExists && n > 0 {
+			exists, err := v.coins.CoinExists(ctx, n)
+			if err != nil {
+				return "", err
+			}
+			if !exists {
+				return fmt.Sprintf("coin %d does not exist", n), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// validateJoin поле join.Field должно быть равно полям join.Parts, соединенным через join.Separator
+// (join.Prefix - начинаться с них и join.Separator)
+func validateJoin(m protoreflect.Message, join *validate.JoinRule) string {
+	fields := m.Descriptor().Fields()
+
+	target := fields.ByName(protoreflect.Name(join.Field))
+	if target == nil {
+		return ""
+	}
+
+	parts := make([]string, 0, len(join.Parts))
+	for _, name := range join.Parts {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil {
+			return ""
+		}
+		parts = append(parts, m.Get(fd).String())
+	}
+
+	value, joined := m.Get(target).String(), strings.Join(parts, join.Separator)
+	if join.Prefix {
+		if !strings.HasPrefix(value, joined+join.Separator) || len(value) == len(joined)+len(join.Separator) {
+			return fmt.Sprintf("value must start with %s + \"%s\"", strings.Join(join.Parts, " + \""+join.Separator+"\" + "), join.Separator)
+		}
+		return ""
+	}
+	if value != joined {
+		return fmt.Sprintf("value must be equal to %s", strings.Join(join.Parts, " + \""+join.Separator+"\" + "))
+	}
+
+	return ""
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(set map[string]bool, list []string) bool {
+	for _, v := range list {
+		if set[v] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package validation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage/memory"
+)
+
+// violatedFields список полей с нарушениями из деталей ошибки
+func violatedFields(t *testing.T, err error) []string {
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+
+	var fields []string
+	var hasMPError bool
+	for _, d := range st.Details() {
+		switch detail := d.(type) {
+		case *errdetails.BadRequest:
+			for _, fv := range detail.FieldViolations {
+				fields = append(fields, fv.Field)
+			}
+		case *proto.MPError:
+			hasMPError = true
+		}
+	}
+	require.True(t, hasMPError)
+
+	return fields
+}
+
+func TestValidator(t *testing.T) {
+	ctx := context.Background()
+	v := NewValidator(memory.NewCoinRepository(map[string]int64{"ALPH": 4}))
+
+	valid := &proto.CreateWorkerRequest{
+		CoinId:       4,
+		Workerfull:   "wallet.worker",
+		Wallet:       "wallet",
+		Worker:       "worker",
+		ServerId:     "SERV",
+		Ip:           "127.0.0.1",
+		RewardMethod: "PPLNS",
+	}
+	require.NoError(t, v.Validate(ctx, "CreateWorker", valid))
+
+	tests := []struct {
+		name   string
+		req    *proto.CreateWorkerRequest
+		fields []string
+	}{
+		{
+			name:   "empty request",
+			req:    &proto.CreateWorkerRequest{},
+			fields: []string{"coin_id", "workerfull", "wallet", "worker", "server_id", "reward_method"},
+		},
+		{
+			name: "unknown coin",
+			req: &proto.CreateWorkerRequest{CoinId: 100, Workerfull: "wallet.worker", Wallet: "wallet", Worker: "worker",
+				ServerId: "SERV", RewardMethod: "PPLNS"},
+			fields: []string{"coin_id"},
+		},
+		{
+			name: "too long workerfull",
+			req: &proto.CreateWorkerRequest{CoinId: 4, Workerfull: "wallet." + strings.Repeat("w", 300), Wallet: "wallet",
+				Worker: strings.Repeat("w", 300), ServerId: "SERV", RewardMethod: "PPLNS"},
+			fields: []string{"workerfull", "worker"},
+		},
+		{
+			name: "unknown reward method",
+			req: &proto.CreateWorkerRequest{CoinId: 4, Workerfull: "wallet.worker", Wallet: "wallet", Worker: "worker",
+				ServerId: "SERV", RewardMethod: "pplns"},
+			fields: []string{"reward_method"},
+		},
+		{
+			name: "workerfull mismatch",
+			req: &proto.CreateWorkerRequest{CoinId: 4, Workerfull: "other.worker", Wallet: "wallet", Worker: "worker",
+				ServerId: "SERV", RewardMethod: "PPLNS"},
+			fields: []string{"workerfull"},
+		},
+		{
+			name: "invalid ip",
+			req: &proto.CreateWorkerRequest{CoinId: 4, Workerfull: "wallet.worker", Wallet: "wallet", Worker: "worker",
+				ServerId: "SERV", RewardMethod: "PPLNS", Ip: "10.0.0.256"},
+			fields: []string{"ip"},
+		},
+	}
+
+	// IPv6 адрес допустим
+	require.NoError(t, v.Validate(ctx, "CreateWorker", &proto.CreateWorkerRequest{CoinId: 4, Workerfull: "wallet.worker",
+		Wallet: "wallet", Worker: "worker", ServerId: "SERV", RewardMethod: "PPLNS", Ip: "2001:db8::ffff:10.0.0.1"}))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(ctx, "CreateWorker", tt.req)
+			require.Error(t, err)
+			require.Equal(t, tt.fields, violatedFields(t, err))
+		})
+	}
+
+	// вложенные сообщения
+	err := v.Validate(ctx, "ResolveMiners", &proto.ResolveMinersRequest{
+		Miners: []*proto.MinerIdentity{
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig", ServerId: "SERV", RewardMethod: "PPLNS"},
+			{Coin: "ALPH", Workerfull: "wallet.rig", ServerId: "SERV", RewardMethod: "PPLNS"},
+		},
+	})
+	require.Equal(t, []string{"miners[1].wallet"}, violatedFields(t, err))
+
+	// полное имя воркера начинается с имени кошелька и точки, имя воркера не пустое
+	err = v.Validate(ctx, "ResolveMiners", &proto.ResolveMinersRequest{
+		Miners: []*proto.MinerIdentity{
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "other.rig", ServerId: "SERV", RewardMethod: "PPLNS"},
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.", ServerId: "SERV", RewardMethod: "PPLNS"},
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "walletrig", ServerId: "SERV", RewardMethod: "PPLNS"},
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig.1", ServerId: "SERV", RewardMethod: "PPLNS"},
+		},
+	})
+	require.Equal(t, []string{"miners[0].workerfull", "miners[1].workerfull", "miners[2].workerfull"}, violatedFields(t, err))
+
+	// обязательное вложенное сообщение и десятичные значения
+	err = v.Validate(ctx, "CreateCoin", &proto.CreateCoinRequest{})
+	require.Equal(t, []string{"coin"}, violatedFields(t, err))
+
+	err = v.Validate(ctx, "CreateCoin", &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "KAS", MinWithdraw: &proto.Decimal{Value: "-1"}, CoinsInBlock: &proto.Decimal{Value: "1e3"}}})
+	require.Equal(t, []string{"coin.min_withdraw.value", "coin.coins_in_block.value"}, violatedFields(t, err))
+
+	err = v.Validate(ctx, "SetPaymentThreshold", &proto.SetPaymentThresholdRequest{WalletId: 1})
+	require.Equal(t, []string{"payment_threshold"}, violatedFields(t, err))
+
+	require.NoError(t, v.Validate(ctx, "CreateCoin", &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "KAS", MinWithdraw: &proto.Decimal{Value: "0.5"}}}))
+}
+
+func TestUnaryServerInterceptorSkip(t *testing.T) {
+	ctx := context.Background()
+	v := NewValidator(memory.NewCoinRepository(map[string]int64{"ALPH": 4}), proto.MinersService_CreateWallet_FullMethodName)
+	interceptor := v.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return req, nil }
+
+	// пропущенный метод вызывается без проверки
+	_, err := interceptor(ctx, &proto.CreateWalletRequest{}, &grpc.UnaryServerInfo{FullMethod: proto.MinersService_CreateWallet_FullMethodName}, handler)
+	require.NoError(t, err)
+
+	_, err = interceptor(ctx, &proto.CreateWorkerRequest{}, &grpc.UnaryServerInfo{FullMethod: proto.MinersService_CreateWorker_FullMethodName}, handler)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
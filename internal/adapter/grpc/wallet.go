@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+var walletSorts = map[proto.WalletSortField]storage.WalletSort{
+	proto.WalletSortField_WALLET_SORT_ID:               storage.WalletSortID,
+	proto.WalletSortField_WALLET_SORT_NAME:             storage.WalletSortName,
+	proto.WalletSortField_WALLET_SORT_CURRENT_HASHRATE: storage.WalletSortCurrentHashrate,
+	proto.WalletSortField_WALLET_SORT_AVERAGE_HASHRATE: storage.WalletSortAverageHashrate,
+	proto.WalletSortField_WALLET_SORT_CREATED_AT:       storage.WalletSortCreatedAt,
+}
+
+// ListWallets список кошельков с отбором и сортировкой, постранично (keyset пагинация по полю сортировки и id)
+func (s *GRPCServer) ListWallets(ctx context.Context, req *proto.ListWalletsRequest) (*proto.ListWalletsResponse, error) {
+	sort, ok := walletSorts[req.Sort]
+	if !ok {
+		return nil, invalidArgument("ListWallets", fmt.Sprintf("sort: unknown sort field %d", req.Sort))
+	}
+
+	filter := storage.WalletFilter{
+		CoinID:       req.CoinId,
+		RewardMethod: entity.RewardMethod(req.RewardMethod),
+		NamePrefix:   req.NamePrefix,
+		MinHashrate:  req.MinHashrate,
+		MaxHashrate:  req.MaxHashrate,
+	}
+	if filter.RewardMethod != "" && !filter.RewardMethod.Valid() {
+		return nil, invalidArgument("ListWallets", fmt.Sprintf("reward_method: unknown reward method %q", req.RewardMethod))
+	}
+	if req.CreatedFrom > 0 {
+		filter.CreatedFrom = time.UnixMilli(req.CreatedFrom).UTC()
+	}
+	if req.CreatedTo > 0 {
+		filter.CreatedTo = time.UnixMilli(req.CreatedTo).UTC()
+	}
+
+	limit := pageSize(req.PageSize, constants.ListWalletsDefaultPageSize, constants.ListWalletsMaxPageSize)
+	page := storage.WalletPage{
+		Sort:  sort,
+		Desc:  req.Desc,
+		Limit: limit + 1, // лишняя запись - признак наличия следующей страницы
+	}
+	if req.PageToken != "" {
+		token, err := decodePageToken(req.PageToken, int32(req.Sort), req.Desc)
+		if err != nil {
+			return nil, invalidArgument("ListWallets", "page_token: "+err.Error())
+		}
+		page.After = walletFromPageToken(token, sort)
+	}
+
+	wallets, err := s.wallets.ListWallets(ctx, filter, page)
+	if err != nil {
+		return nil, statusError("ListWallets", err)
+	}
+
+	resp := &proto.ListWalletsResponse{}
+	if len(wallets) > limit {
+		wallets = wallets[:limit]
+		resp.NextPageToken = walletPageToken(wallets[limit-1], req.Sort, req.Desc).encode()
+	}
+
+	resp.Wallets = make([]*proto.WalletInfo, len(wallets))
+	for i, w := range wallets {
+		resp.Wallets[i] = walletToProto(w)
+	}
+
+	return resp, nil
+}
+
+func walletPageToken(w entity.Wallet, sort proto.WalletSortField, desc bool) pageToken {
+	t := pageToken{Sort: int32(sort), Desc: desc, ID: w.ID}
+	switch sort {
+	case proto.WalletSortField_WALLET_SORT_NAME:
+		t.Str = w.Name
+	case proto.WalletSortField_WALLET_SORT_CURRENT_HASHRATE:
+		t.Int = w.CurrentHashrate
+	case proto.WalletSortField_WALLET_SORT_AVERAGE_HASHRATE:
+		t.Int = w.AverageHashrate
+	case proto.WalletSortField_WALLET_SORT_CREATED_AT:
+		t.Int = unixMilli(w.CreatedAt)
+	}
+
+	return t
+}
+
+func walletFromPageToken(t pageToken, sort storage.WalletSort) *entity.Wallet {
+	w := &entity.Wallet{ID: t.ID}
+	switch sort {
+	case storage.WalletSortName:
+		w.Name = t.Str
+	case storage.WalletSortCurrentHashrate:
+		w.CurrentHashrate = t.Int
+	case storage.WalletSortAverageHashrate:
+		w.AverageHashrate = t.Int
+	case storage.WalletSortCreatedAt:
+		if t.Int != 0 {
+			w.CreatedAt = time.UnixMilli(t.Int).UTC()
+		}
+	}
+
+	return w
+}
+
+func walletToProto(w entity.Wallet) *proto.WalletInfo {
+	return &proto.WalletInfo{
+		Id:               w.ID,
+		CoinId:           w.CoinID,
+		Name:             w.Name,
+		RewardMethod:     string(w.RewardMethod),
+		CurrentHashrate:  w.CurrentHashrate,
+		AverageHashrate:  w.AverageHashrate,
+		CreatedAt:        unixMilli(w.CreatedAt),
+		PaymentThreshold: decimalToProto(w.PaymentThreshold),
+	}
+}
+
+// unixMilli время в unix time в миллисекундах (нулевое время - 0)
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+// GetWallet кошелек по ID (неизвестный кошелек - codes.NotFound)
+func (s *GRPCServer) GetWallet(ctx context.Context, req *proto.GetWalletRequest) (*proto.GetWalletResponse, error) {
+	wallet, err := s.wallets.GetWallet(ctx, req.Id)
+	if err != nil {
+		return nil, statusError("GetWallet", err)
+	}
+
+	return &proto.GetWalletResponse{Wallet: walletToProto(wallet)}, nil
+}
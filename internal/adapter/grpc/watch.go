@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"google.golang.org/grpc/codes"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// WatchWorkerStatus поток событий подключения/отключения воркеров (для сервиса уведомлений)
+// Поток завершается при отключении клиента, остановке сервиса (codes.Unavailable)
+// или переполнении буфера клиента (codes.ResourceExhausted)
+func (s *GRPCServer) WatchWorkerStatus(req *proto.WatchWorkerStatusRequest, stream proto.MinersService_WatchWorkerStatusServer) error {
+	if s.workerEvents == nil {
+		return statusWithDetail(codes.Unimplemented, "WatchWorkerStatus", "worker status events are disabled")
+	}
+
+	ctx := stream.Context()
+	sub := s.workerEvents.Subscribe(constants.WatchWorkerStatusBuffer)
+	defer s.workerEvents.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.C:
+			if !ok {
+				if sub.Overflow() {
+					return statusWithDetail(codes.ResourceExhausted, "WatchWorkerStatus", "client is too slow, events were lost")
+				}
+				return statusWithDetail(codes.Unavailable, "WatchWorkerStatus", "server is shutting down")
+			}
+			if req.CoinId > 0 && e.CoinID != req.CoinId || req.Wallet != "" && e.Wallet != req.Wallet {
+				continue
+			}
+			if err := stream.Send(workerStatusEventToProto(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func workerStatusEventToProto(e entity.WorkerStatusEvent) *proto.WorkerStatusEvent {
+	return &proto.WorkerStatusEvent{
+		WorkerId:      e.WorkerID,
+		CoinId:        e.CoinID,
+		Wallet:        e.Wallet,
+		Workerfull:    e.Workerfull,
+		RewardMethod:  string(e.RewardMethod),
+		Online:        e.Online,
+		LastShareDate: unixMilli(e.LastShareDate),
+		Time:          unixMilli(e.Time),
+	}
+}
@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// ListWorkersByWallet воркеры кошелька (монета + метод начисления) с состоянием подключения, постранично по id
+// кошелек без воркеров или несуществующий кошелек - пустой список
+func (s *GRPCServer) ListWorkersByWallet(ctx context.Context, req *proto.ListWorkersByWalletRequest) (*proto.ListWorkersByWalletResponse, error) {
+	filter := storage.WorkerFilter{
+		Wallet:       req.Wallet,
+		CoinID:       req.CoinId,
+		RewardMethod: entity.RewardMethod(req.RewardMethod),
+	}
+	switch req.Status {
+	case proto.WorkerStatusFilter_WORKER_STATUS_ALL:
+	case proto.WorkerStatusFilter_WORKER_STATUS_ONLINE:
+		online := true
+		filter.Online = &online
+	case proto.WorkerStatusFilter_WORKER_STATUS_OFFLINE:
+		online := false
+		filter.Online = &online
+	default:
+		return nil, invalidArgument("ListWorkersByWallet", "status: unknown status filter")
+	}
+
+	limit := pageSize(req.PageSize, constants.ListWorkersDefaultPageSize, constants.ListWorkersMaxPageSize)
+	page := storage.WorkerPage{
+		Limit: limit + 1, // лишняя запись - признак наличия следующей страницы
+	}
+	if req.PageToken != "" {
+		token, err := decodePageToken(req.PageToken, 0, false)
+		if err != nil {
+			return nil, invalidArgument("ListWorkersByWallet", "page_token: "+err.Error())
+		}
+		page.AfterID = token.ID
+	}
+
+	workers, err := s.workers.ListWorkersByWallet(ctx, filter, page)
+	if err != nil {
+		return nil, statusError("ListWorkersByWallet", err)
+	}
+
+	resp := &proto.ListWorkersByWalletResponse{}
+	if len(workers) > limit {
+		workers = workers[:limit]
+		resp.NextPageToken = pageToken{ID: workers[limit-1].ID}.encode()
+	}
+
+	resp.Workers = make([]*proto.WorkerInfo, len(workers))
+	for i, w := range workers {
+		resp.Workers[i] = workerToProto(w)
+	}
+
+	return resp, nil
+}
+
+func workerToProto(w entity.Worker) *proto.WorkerInfo {
+	return &proto.WorkerInfo{
+		Id:              w.ID,
+		Workerfull:      w.Workerfull,
+		Worker:          w.Worker,
+		ServerId:        w.ServerID,
+		Ip:              w.IP,
+		IsConnect:       w.IsConnect,
+		CurrentHashrate: w.CurrentHashrate,
+		AverageHashrate: w.AverageHashrate,
+		LastShareDate:   unixMilli(w.LastShareDate),
+		CurrentDiff:     decimalToProto(w.CurrentDiff),
+		MinerClient:     w.MinerClient,
+
+		ReportedHashrate:     w.ReportedHashrate,
+		ReportedHashrateDate: unixMilli(w.ReportedHashrateDate),
+	}
+}
+
+// UpdateWorkerStats пакетное обновление состояния воркеров (вызывается сервисом обработки шар периодически)
+func (s *GRPCServer) UpdateWorkerStats(ctx context.Context, req *proto.UpdateWorkerStatsRequest) (*proto.UpdateWorkerStatsResponse, error) {
+	if len(req.Stats) > constants.UpdateWorkerStatsMaxBatch {
+		return nil, invalidArgument("UpdateWorkerStats", fmt.Sprintf("too many workers in request: %d, max %d", len(req.Stats), constants.UpdateWorkerStatsMaxBatch))
+	}
+
+	stats := make([]entity.WorkerStats, len(req.Stats))
+	for i, st := range req.Stats {
+		currentDiff, err := optionalDecimalFromProto(st.CurrentDiff)
+		if err != nil {
+			return nil, invalidArgument("UpdateWorkerStats", fmt.Sprintf("stats[%d].current_diff: %s", i, err))
+		}
+		stats[i] = entity.WorkerStats{
+			WorkerID:         st.WorkerId,
+			IsConnect:        st.IsConnect,
+			CurrentHashrate:  st.CurrentHashrate,
+			AverageHashrate:  st.AverageHashrate,
+			CurrentDiff:      currentDiff,
+			ReportedHashrate: st.ReportedHashrate,
+		}
+		if st.LastShareDate > 0 {
+			stats[i].LastShareDate = time.UnixMilli(st.LastShareDate)
+		}
+	}
+
+	updated, _, err := s.workerStats.UpdateWorkerStats(ctx, stats)
+	if err != nil {
+		return nil, statusError("UpdateWorkerStats", err)
+	}
+
+	return &proto.UpdateWorkerStatsResponse{Updated: int32(updated)}, nil
+}
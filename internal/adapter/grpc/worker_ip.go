@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+)
+
+// GetWorkerIPHistory адреса, с которых подключался воркер (последние сначала)
+func (s *GRPCServer) GetWorkerIPHistory(ctx context.Context, req *proto.GetWorkerIPHistoryRequest) (*proto.GetWorkerIPHistoryResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 || limit > constants.WorkerIPHistoryMaxLimit {
+		limit = constants.WorkerIPHistoryMaxLimit
+	}
+
+	history, err := s.workers.GetWorkerIPHistory(ctx, req.WorkerId, limit)
+	if err != nil {
+		return nil, statusError("GetWorkerIPHistory", err)
+	}
+
+	resp := &proto.GetWorkerIPHistoryResponse{
+		History: make([]*proto.WorkerIP, len(history)),
+	}
+	for i, h := range history {
+		resp.History[i] = &proto.WorkerIP{
+			Ip:        h.IP,
+			FirstSeen: h.FirstSeen.UnixMilli(),
+			LastSeen:  h.LastSeen.UnixMilli(),
+		}
+	}
+
+	return resp, nil
+}
+
+// workerIP адрес воркера в каноническом виде или пустая строка, если это не адрес IPv4/IPv6
+// (методы версии 1 запросы не проверяют - некорректный адрес не сохраняется, запрос не отклоняется)
+func workerIP(s string) string {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return ""
+	}
+	ip := addr.String()
+	if len(ip) > constants.WorkerIPMaxLen {
+		return ""
+	}
+
+	return ip
+}
@@ -0,0 +1,203 @@
+package sharesprocessor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dnsoftware/mpm-save-get-shares/pkg/logger"
+	"google.golang.org/grpc"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/sharesprocessor/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// Resolver получение адреса сервиса по ключу (реализуется ServiceDiscovery)
+type Resolver interface {
+	DiscoverService(key string) (string, error)
+}
+
+// Client gRPC клиент сервиса процессинга шар
+// при смене адреса в ServiceDiscovery соединение пересоздается, текущие вызовы завершаются на старом соединении
+type Client struct {
+	mu       sync.RWMutex
+	addr     string
+	conn     *clientConn
+	dialOpts []grpc.DialOption
+}
+
+// clientConn соединение с учетом текущих вызовов: замененное соединение закрывается после их завершения
+type clientConn struct {
+	conn   *grpc.ClientConn
+	client sharesproto.SharesProcessorServiceClient
+	calls  sync.WaitGroup
+}
+
+// closeAfterCalls закрытие соединения после завершения текущих вызовов (новые вызовы соединение уже не получают)
+func (cc *clientConn) closeAfterCalls() error {
+	cc.calls.Wait()
+	return cc.conn.Close()
+}
+
+// NewClient opts - параметры соединения (TLS, JWT интерсепторы), применяются и при переподключении
+func NewClient(addr string, opts ...grpc.DialOption) (*Client, error) {
+	c := &Client{dialOpts: opts}
+	if err := c.SetAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Address текущий адрес сервиса
+func (c *Client) Address() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.addr
+}
+
+// SetAddress переподключение к новому адресу (старое соединение закрывается в фоне после завершения текущих вызовов)
+func (c *Client) SetAddress(addr string) error {
+	if addr == "" {
+		return fmt.Errorf("shares processor address is empty")
+	}
+
+	c.mu.RLock()
+	same := c.addr == addr && c.conn != nil
+	c.mu.RUnlock()
+	if same {
+		return nil
+	}
+
+	// соединение устанавливается лениво, при первом вызове
+	conn, err := grpc.NewClient(addr, c.dialOpts...)
+	if err != nil {
+		return fmt.Errorf("shares processor dial %s: %w", addr, err)
+	}
+
+	c.mu.Lock()
+	old := c.conn
+	c.addr, c.conn = addr, &clientConn{conn: conn, client: sharesproto.NewSharesProcessorServiceClient(conn)}
+	c.mu.Unlock()
+
+	if old != nil {
+		go func() {
+			if err := old.closeAfterCalls(); err != nil {
+				logger.Log().Error("Shares processor close old connection error: " + err.Error())
+			}
+		}()
+	}
+
+	return nil
+}
+
+// AddShares передача пакета шар, возвращает количество принятых сервисом шар
+func (c *Client) AddShares(ctx context.Context, shares []entity.Share) (int, error) {
+	req := &sharesproto.AddSharesRequest{Shares: make([]*sharesproto.Share, 0, len(shares))}
+	for _, s := range shares {
+		ps, err := shareToProto(s)
+		if err != nil {
+			return 0, err
+		}
+		req.Shares = append(req.Shares, ps)
+	}
+
+	// вызов учитывается в соединении под блокировкой клиента - замененное соединение не закроется до его завершения
+	c.mu.RLock()
+	cc := c.conn
+	if cc != nil {
+		cc.calls.Add(1)
+	}
+	c.mu.RUnlock()
+	if cc == nil {
+		return 0, fmt.Errorf("shares processor client is closed")
+	}
+	defer cc.calls.Done()
+
+	ctx, cancel := context.WithTimeout(ctx, constants.SharesProcessorCallTimeout*time.Second)
+	defer cancel()
+
+	resp, err := cc.client.AddShares(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.Added), nil
+}
+
+// ForwardShares реализация grpc.SharesForwarder
+func (c *Client) ForwardShares(ctx context.Context, shares []entity.Share) error {
+	_, err := c.AddShares(ctx, shares)
+	return err
+}
+
+// WatchAddress периодическое получение адреса из ServiceDiscovery до отмены контекста
+// ошибки получения адреса и переподключения логируются, клиент продолжает работать со старым адресом
+func (c *Client) WatchAddress(ctx context.Context, resolver Resolver, serviceKey string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			addr, err := resolver.DiscoverService(serviceKey)
+			if err != nil {
+				logger.Log().Error("Shares processor discovery error: " + err.Error())
+				continue
+			}
+			if addr == "" || addr == c.Address() {
+				continue
+			}
+			if err := c.SetAddress(addr); err != nil {
+				logger.Log().Error("Shares processor reconnect error: " + err.Error())
+				continue
+			}
+			logger.Log().Info("Shares processor address changed: " + addr)
+		}
+	}
+}
+
+// Close закрытие соединения (ожидает завершения текущих вызовов, не дольше таймаута вызова)
+func (c *Client) Close() error {
+	c.mu.Lock()
+	cc := c.conn
+	c.conn = nil
+	c.mu.Unlock()
+
+	if cc == nil {
+		return nil
+	}
+
+	return cc.closeAfterCalls()
+}
+
+func shareToProto(s entity.Share) (*sharesproto.Share, error) {
+	date, err := strconv.ParseInt(s.ShareDate, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("share %s: invalid share date %q", s.UUID, s.ShareDate)
+	}
+
+	ps := &sharesproto.Share{
+		Uuid:         s.UUID,
+		ServerId:     s.ServerID,
+		CoinId:       s.CoinID,
+		WorkerId:     s.WorkerID,
+		WalletId:     s.WalletID,
+		ShareDate:    date,
+		Difficulty:   &sharesproto.Decimal{Value: s.Difficulty.String()},
+		Sharedif:     &sharesproto.Decimal{Value: s.Sharedif.String()},
+		Nonce:        s.Nonce,
+		RewardMethod: string(s.RewardMethod),
+	}
+	if s.Cost != nil {
+		ps.Cost = &sharesproto.Decimal{Value: s.Cost.String()}
+	}
+
+	return ps, nil
+}
@@ -0,0 +1,173 @@
+package sharesprocessor
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/sharesprocessor/proto"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// fakeProcessor сервис процессинга шар, запоминающий полученные шары
+// (если задан hold - вызов сообщает о начале в entered и ждет закрытия hold)
+type fakeProcessor struct {
+	sharesproto.UnimplementedSharesProcessorServiceServer
+	mu      sync.Mutex
+	shares  []*sharesproto.Share
+	hold    chan struct{}
+	entered chan struct{}
+}
+
+func (f *fakeProcessor) AddShares(ctx context.Context, req *sharesproto.AddSharesRequest) (*sharesproto.AddSharesResponse, error) {
+	if f.hold != nil {
+		f.entered <- struct{}{}
+		<-f.hold
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.shares = append(f.shares, req.Shares...)
+	return &sharesproto.AddSharesResponse{Added: int32(len(req.Shares))}, nil
+}
+
+func (f *fakeProcessor) received() []*sharesproto.Share {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*sharesproto.Share(nil), f.shares...)
+}
+
+// startProcessors поднимает по серверу на каждый адрес, соединение выбирается по адресу
+func startProcessors(t *testing.T, addrs ...string) (map[string]*fakeProcessor, grpc.DialOption) {
+	listeners := make(map[string]*bufconn.Listener)
+	processors := make(map[string]*fakeProcessor)
+	for _, addr := range addrs {
+		lis := bufconn.Listen(1024 * 1024)
+		srv := grpc.NewServer()
+		p := &fakeProcessor{}
+		sharesproto.RegisterSharesProcessorServiceServer(srv, p)
+		go func() { _ = srv.Serve(lis) }()
+		t.Cleanup(srv.Stop)
+		listeners[addr], processors[addr] = lis, p
+	}
+
+	dialer := grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		return listeners[addr].DialContext(ctx)
+	})
+
+	return processors, dialer
+}
+
+type staticResolver struct {
+	mu   sync.Mutex
+	addr string
+}
+
+func (r *staticResolver) DiscoverService(key string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.addr, nil
+}
+
+func (r *staticResolver) set(addr string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.addr = addr
+}
+
+func TestClientForwardShares(t *testing.T) {
+	processors, dialer := startProcessors(t, "first")
+
+	client, err := NewClient("passthrough:///first", dialer, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer client.Close()
+
+	share := entity.Share{UUID: "u1", ServerID: "ALEPH-1", CoinID: 4, WorkerID: 2, WalletID: 1, ShareDate: "1700000000123",
+		Difficulty: entity.MustParseDecimal("1.5"), Sharedif: entity.MustParseDecimal("2.250"), Nonce: "abc", RewardMethod: entity.RewardMethod("PPLNS")}
+	added, err := client.AddShares(context.Background(), []entity.Share{share})
+	require.NoError(t, err)
+	require.Equal(t, 1, added)
+
+	got := processors["first"].received()
+	require.Len(t, got, 1)
+	require.Equal(t, "u1", got[0].Uuid)
+	require.Equal(t, int64(1700000000123), got[0].ShareDate)
+	require.Equal(t, "2.250", got[0].Sharedif.Value)
+	require.Nil(t, got[0].Cost)
+	require.Equal(t, "PPLNS", got[0].RewardMethod)
+
+	// некорректное время шары не отправляется
+	share.ShareDate = "yesterday"
+	require.Error(t, client.ForwardShares(context.Background(), []entity.Share{share}))
+	require.Len(t, processors["first"].received(), 1)
+
+	_, err = NewClient("")
+	require.Error(t, err)
+}
+
+func TestClientWatchAddress(t *testing.T) {
+	processors, dialer := startProcessors(t, "first", "second")
+
+	client, err := NewClient("passthrough:///first", dialer, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer client.Close()
+
+	share := entity.Share{UUID: "u1", ShareDate: "1"}
+	require.NoError(t, client.ForwardShares(context.Background(), []entity.Share{share}))
+
+	resolver := &staticResolver{addr: "passthrough:///first"}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go client.WatchAddress(ctx, resolver, "mpm_shares_processor:grpc", 10*time.Millisecond)
+
+	// пустой адрес (сервис временно не зарегистрирован) не сбрасывает соединение
+	resolver.set("")
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, "passthrough:///first", client.Address())
+
+	resolver.set("passthrough:///second")
+	require.Eventually(t, func() bool {
+		return client.Address() == "passthrough:///second"
+	}, time.Second, 10*time.Millisecond)
+
+	share.UUID = "u2"
+	require.NoError(t, client.ForwardShares(context.Background(), []entity.Share{share}))
+	require.Len(t, processors["first"].received(), 1)
+	require.Len(t, processors["second"].received(), 1)
+	require.Equal(t, "u2", processors["second"].received()[0].Uuid)
+
+	require.NoError(t, client.Close())
+	require.Error(t, client.ForwardShares(context.Background(), []entity.Share{share}))
+}
+
+func TestClientSetAddressDrainsCalls(t *testing.T) {
+	processors, dialer := startProcessors(t, "first", "second")
+	processors["first"].hold = make(chan struct{})
+	processors["first"].entered = make(chan struct{}, 1)
+
+	client, err := NewClient("passthrough:///first", dialer, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer client.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- client.ForwardShares(context.Background(), []entity.Share{{UUID: "u1", ShareDate: "1"}})
+	}()
+	<-processors["first"].entered
+
+	// смена адреса во время вызова - вызов завершается на старом соединении
+	require.NoError(t, client.SetAddress("passthrough:///second"))
+	close(processors["first"].hold)
+	require.NoError(t, <-done)
+	require.Len(t, processors["first"].received(), 1)
+
+	require.NoError(t, client.ForwardShares(context.Background(), []entity.Share{{UUID: "u2", ShareDate: "1"}}))
+	require.Len(t, processors["second"].received(), 1)
+}
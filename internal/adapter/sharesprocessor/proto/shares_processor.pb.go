@@ -0,0 +1,455 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v3.12.4
+// source: proto/sharesprocessor/shares_processor.proto
+
+package sharesproto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Share struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                         // уникальный идентификатор
+	ServerId     string   `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"` // идентификатор пул-сервера (типа ALEPH-1 и т.п.)
+	CoinId       int64    `protobuf:"varint,3,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	WorkerId     int64    `protobuf:"varint,4,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	WalletId     int64    `protobuf:"varint,5,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	ShareDate    int64    `protobuf:"varint,6,opt,name=share_date,json=shareDate,proto3" json:"share_date,omitempty"` // unix time в миллисекундах
+	Nonce        string   `protobuf:"bytes,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	RewardMethod string   `protobuf:"bytes,10,opt,name=reward_method,json=rewardMethod,proto3" json:"reward_method,omitempty"`
+	Difficulty   *Decimal `protobuf:"bytes,12,opt,name=difficulty,proto3" json:"difficulty,omitempty"` // сложность майнера
+	Sharedif     *Decimal `protobuf:"bytes,13,opt,name=sharedif,proto3" json:"sharedif,omitempty"`     // реальная сложность шары
+	Cost         *Decimal `protobuf:"bytes,14,opt,name=cost,proto3" json:"cost,omitempty"`             // награда за шару (не задана - не рассчитана)
+}
+
+func (x *Share) Reset() {
+	*x = Share{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sharesprocessor_shares_processor_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Share) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Share) ProtoMessage() {}
+
+func (x *Share) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sharesprocessor_shares_processor_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Share.ProtoReflect.Descriptor instead.
+func (*Share) Descriptor() ([]byte, []int) {
+	return file_proto_sharesprocessor_shares_processor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Share) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Share) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Share) GetCoinId() int64 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+func (x *Share) GetWorkerId() int64 {
+	if x != nil {
+		return x.WorkerId
+	}
+	return 0
+}
+
+func (x *Share) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *Share) GetShareDate() int64 {
+	if x != nil {
+		return x.ShareDate
+	}
+	return 0
+}
+
+func (x *Share) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *Share) GetRewardMethod() string {
+	if x != nil {
+		return x.RewardMethod
+	}
+	return ""
+}
+
+func (x *Share) GetDifficulty() *Decimal {
+	if x != nil {
+		return x.Difficulty
+	}
+	return nil
+}
+
+func (x *Share) GetSharedif() *Decimal {
+	if x != nil {
+		return x.Sharedif
+	}
+	return nil
+}
+
+func (x *Share) GetCost() *Decimal {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+// Десятичное число без потери точности: строка вида 123.456, масштаб сохраняется
+type Decimal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Decimal) Reset() {
+	*x = Decimal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sharesprocessor_shares_processor_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Decimal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decimal) ProtoMessage() {}
+
+func (x *Decimal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sharesprocessor_shares_processor_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decimal.ProtoReflect.Descriptor instead.
+func (*Decimal) Descriptor() ([]byte, []int) {
+	return file_proto_sharesprocessor_shares_processor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Decimal) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AddSharesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shares []*Share `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *AddSharesRequest) Reset() {
+	*x = AddSharesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sharesprocessor_shares_processor_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSharesRequest) ProtoMessage() {}
+
+func (x *AddSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sharesprocessor_shares_processor_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSharesRequest.ProtoReflect.Descriptor instead.
+func (*AddSharesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sharesprocessor_shares_processor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddSharesRequest) GetShares() []*Share {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type AddSharesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added int32 `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"` // количество принятых шар (без уже известных сервису)
+}
+
+func (x *AddSharesResponse) Reset() {
+	*x = AddSharesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sharesprocessor_shares_processor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSharesResponse) ProtoMessage() {}
+
+func (x *AddSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sharesprocessor_shares_processor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSharesResponse.ProtoReflect.Descriptor instead.
+func (*AddSharesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_sharesprocessor_shares_processor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddSharesResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+var File_proto_sharesprocessor_shares_processor_proto protoreflect.FileDescriptor
+
+var file_proto_sharesprocessor_shares_processor_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x22,
+	0x95, 0x03, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x69, 0x66, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x08, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x69, 0x66, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52,
+	0x04, 0x63, 0x6f, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x08, 0x10,
+	0x09, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x22, 0x1f, 0x0a, 0x07, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x42, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x11,
+	0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x32, 0x6c, 0x0a, 0x16, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x52, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x21,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x2e, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x5f, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6e, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x2f, 0x6d,
+	0x70, 0x6d, 0x2d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_sharesprocessor_shares_processor_proto_rawDescOnce sync.Once
+	file_proto_sharesprocessor_shares_processor_proto_rawDescData = file_proto_sharesprocessor_shares_processor_proto_rawDesc
+)
+
+func file_proto_sharesprocessor_shares_processor_proto_rawDescGZIP() []byte {
+	file_proto_sharesprocessor_shares_processor_proto_rawDescOnce.Do(func() {
+		file_proto_sharesprocessor_shares_processor_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_sharesprocessor_shares_processor_proto_rawDescData)
+	})
+	return file_proto_sharesprocessor_shares_processor_proto_rawDescData
+}
+
+var file_proto_sharesprocessor_shares_processor_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_sharesprocessor_shares_processor_proto_goTypes = []interface{}{
+	(*Share)(nil),             // 0: sharesprocessor.Share
+	(*Decimal)(nil),           // 1: sharesprocessor.Decimal
+	(*AddSharesRequest)(nil),  // 2: sharesprocessor.AddSharesRequest
+	(*AddSharesResponse)(nil), // 3: sharesprocessor.AddSharesResponse
+}
+var file_proto_sharesprocessor_shares_processor_proto_depIdxs = []int32{
+	1, // 0: sharesprocessor.Share.difficulty:type_name -> sharesprocessor.Decimal
+	1, // 1: sharesprocessor.Share.sharedif:type_name -> sharesprocessor.Decimal
+	1, // 2: sharesprocessor.Share.cost:type_name -> sharesprocessor.Decimal
+	0, // 3: sharesprocessor.AddSharesRequest.shares:type_name -> sharesprocessor.Share
+	2, // 4: sharesprocessor.SharesProcessorService.AddShares:input_type -> sharesprocessor.AddSharesRequest
+	3, // 5: sharesprocessor.SharesProcessorService.AddShares:output_type -> sharesprocessor.AddSharesResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_sharesprocessor_shares_processor_proto_init() }
+func file_proto_sharesprocessor_shares_processor_proto_init() {
+	if File_proto_sharesprocessor_shares_processor_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_sharesprocessor_shares_processor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Share); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sharesprocessor_shares_processor_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Decimal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sharesprocessor_shares_processor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSharesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sharesprocessor_shares_processor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSharesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_sharesprocessor_shares_processor_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_sharesprocessor_shares_processor_proto_goTypes,
+		DependencyIndexes: file_proto_sharesprocessor_shares_processor_proto_depIdxs,
+		MessageInfos:      file_proto_sharesprocessor_shares_processor_proto_msgTypes,
+	}.Build()
+	File_proto_sharesprocessor_shares_processor_proto = out.File
+	file_proto_sharesprocessor_shares_processor_proto_rawDesc = nil
+	file_proto_sharesprocessor_shares_processor_proto_goTypes = nil
+	file_proto_sharesprocessor_shares_processor_proto_depIdxs = nil
+}
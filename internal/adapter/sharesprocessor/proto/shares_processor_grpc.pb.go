@@ -0,0 +1,110 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.12.4
+// source: proto/sharesprocessor/shares_processor.proto
+
+package sharesproto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SharesProcessorService_AddShares_FullMethodName = "/sharesprocessor.SharesProcessorService/AddShares"
+)
+
+// SharesProcessorServiceClient is the client API for SharesProcessorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SharesProcessorServiceClient interface {
+	AddShares(ctx context.Context, in *AddSharesRequest, opts ...grpc.CallOption) (*AddSharesResponse, error)
+}
+
+type sharesProcessorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSharesProcessorServiceClient(cc grpc.ClientConnInterface) SharesProcessorServiceClient {
+	return &sharesProcessorServiceClient{cc}
+}
+
+func (c *sharesProcessorServiceClient) AddShares(ctx context.Context, in *AddSharesRequest, opts ...grpc.CallOption) (*AddSharesResponse, error) {
+	out := new(AddSharesResponse)
+	err := c.cc.Invoke(ctx, SharesProcessorService_AddShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SharesProcessorServiceServer is the server API for SharesProcessorService service.
+// All implementations must embed UnimplementedSharesProcessorServiceServer
+// for forward compatibility
+type SharesProcessorServiceServer interface {
+	AddShares(context.Context, *AddSharesRequest) (*AddSharesResponse, error)
+	mustEmbedUnimplementedSharesProcessorServiceServer()
+}
+
+// UnimplementedSharesProcessorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSharesProcessorServiceServer struct {
+}
+
+func (UnimplementedSharesProcessorServiceServer) AddShares(context.Context, *AddSharesRequest) (*AddSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddShares not implemented")
+}
+func (UnimplementedSharesProcessorServiceServer) mustEmbedUnimplementedSharesProcessorServiceServer() {
+}
+
+// UnsafeSharesProcessorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SharesProcessorServiceServer will
+// result in compilation errors.
+type UnsafeSharesProcessorServiceServer interface {
+	mustEmbedUnimplementedSharesProcessorServiceServer()
+}
+
+func RegisterSharesProcessorServiceServer(s grpc.ServiceRegistrar, srv SharesProcessorServiceServer) {
+	s.RegisterService(&SharesProcessorService_ServiceDesc, srv)
+}
+
+func _SharesProcessorService_AddShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharesProcessorServiceServer).AddShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharesProcessorService_AddShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharesProcessorServiceServer).AddShares(ctx, req.(*AddSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SharesProcessorService_ServiceDesc is the grpc.ServiceDesc for SharesProcessorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SharesProcessorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sharesprocessor.SharesProcessorService",
+	HandlerType: (*SharesProcessorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddShares",
+			Handler:    _SharesProcessorService_AddShares_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/sharesprocessor/shares_processor.proto",
+}
@@ -0,0 +1,178 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage/memory"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// countingWallets считает обращения к нижележащему хранилищу
+type countingWallets struct {
+	storage.WalletRepository
+	gets int
+}
+
+func (c *countingWallets) GetWalletByName(ctx context.Context, name string, coinID int64, rewardMethod entity.RewardMethod) (entity.Wallet, error) {
+	c.gets++
+	return c.WalletRepository.GetWalletByName(ctx, name, coinID, rewardMethod)
+}
+
+func TestLRUEviction(t *testing.T) {
+	c := newLRU[string, int64](2, time.Minute)
+	c.add("a", 1)
+	c.add("b", 2)
+
+	_, _, found := c.get("a") // "a" становится самым свежим
+	require.True(t, found)
+
+	c.add("c", 3) // вытесняется "b"
+	_, _, found = c.get("b")
+	require.False(t, found)
+
+	v, _, found := c.get("a")
+	require.True(t, found)
+	require.Equal(t, int64(1), v)
+
+	st := c.stats()
+	require.Equal(t, uint64(2), st.Hits)
+	require.Equal(t, uint64(1), st.Misses)
+	require.Equal(t, 2, st.Size)
+}
+
+func TestWalletCache(t *testing.T) {
+	ctx := context.Background()
+	next := &countingWallets{WalletRepository: memory.NewWalletRepository()}
+	repo := NewWalletRepository(next, 10, time.Minute)
+
+	now := time.Now()
+	repo.cache.now = func() time.Time { return now }
+
+	// отрицательный результат кэшируется
+	_, err := repo.GetWalletByName(ctx, "wallet", 4, "PPLNS")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = repo.GetWalletByName(ctx, "wallet", 4, "PPLNS")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	require.Equal(t, 1, next.gets)
+
+	// создание сбрасывает отрицательный результат
+	id, err := repo.CreateWallet(ctx, entity.Wallet{Name: "wallet", CoinID: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+
+	w, err := repo.GetWalletByName(ctx, "wallet", 4, "PPLNS")
+	require.NoError(t, err)
+	require.Equal(t, id, w.ID)
+	require.Equal(t, 2, next.gets)
+
+	// найденное значение берется из кэша
+	w, err = repo.GetWalletByName(ctx, "wallet", 4, "PPLNS")
+	require.NoError(t, err)
+	require.Equal(t, id, w.ID)
+	require.Equal(t, 2, next.gets)
+
+	// отрицательный результат истекает по TTL
+	_, err = repo.GetWalletByName(ctx, "other", 4, "PPLNS")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	require.Equal(t, 3, next.gets)
+	now = now.Add(2 * time.Minute)
+	_, err = repo.GetWalletByName(ctx, "other", 4, "PPLNS")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	require.Equal(t, 4, next.gets)
+
+	st := repo.Stats()
+	require.Equal(t, uint64(2), st.Hits)
+	require.Equal(t, uint64(1), st.NegativeHits)
+	require.Equal(t, uint64(4), st.Misses)
+}
+
+func TestCoinCache(t *testing.T) {
+	ctx := context.Background()
+	repo := NewCoinRepository(memory.NewCoinRepository(map[string]int64{"ALPH": 4}), 10, time.Minute)
+
+	// регистр символа не влияет на ключ кэша
+	id, err := repo.GetCoinIDBySymbol(ctx, "ALPH", false)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), id)
+	id, err = repo.GetCoinIDBySymbol(ctx, "alph", false)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), id)
+	require.Equal(t, uint64(1), repo.Stats().Hits)
+
+	// выключение монеты сбрасывает кэш символов
+	require.NoError(t, repo.SetCoinActive(ctx, 4, false))
+	_, err = repo.GetCoinIDBySymbol(ctx, "alph", false)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	id, err = repo.GetCoinIDBySymbol(ctx, "alph", true)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), id)
+}
+
+func TestMinerRemoverCache(t *testing.T) {
+	ctx := context.Background()
+	memWallets, memWorkers := memory.NewWalletRepository(), memory.NewWorkerRepository()
+	wallets := NewWalletRepository(memWallets, 10, 0)
+	workers := NewWorkerRepository(memWorkers, 10, 0)
+	remover := NewMinerRemover(memory.NewMinerRemover(memWallets, memWorkers), wallets, workers)
+
+	walletID, err := wallets.CreateWallet(ctx, entity.Wallet{Name: "wallet", CoinID: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	workerIDs := make([]int64, 0)
+	for _, name := range []string{"wallet.rig1", "wallet.rig2"} {
+		id, err := workers.CreateWorker(ctx, entity.Worker{Workerfull: name, Wallet: "wallet", CoinID: 4, RewardMethod: "PPLNS"})
+		require.NoError(t, err)
+		workerIDs = append(workerIDs, id)
+
+		// запись попадает в кэш
+		_, err = workers.GetWorkerByName(ctx, name, 4, "PPLNS")
+		require.NoError(t, err)
+	}
+	_, err = wallets.GetWalletByName(ctx, "wallet", 4, "PPLNS")
+	require.NoError(t, err)
+
+	_, err = remover.DeleteWorker(ctx, workerIDs[0])
+	require.NoError(t, err)
+	_, err = workers.GetWorkerByName(ctx, "wallet.rig1", 4, "PPLNS")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+
+	// удаление кошелька сбрасывает и записи его воркеров
+	_, deleted, err := remover.DeleteWallet(ctx, walletID)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	_, err = wallets.GetWalletByName(ctx, "wallet", 4, "PPLNS")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = workers.GetWorkerByName(ctx, "wallet.rig2", 4, "PPLNS")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestMinerResolverCache(t *testing.T) {
+	ctx := context.Background()
+	memWallets, memWorkers := memory.NewWalletRepository(), memory.NewWorkerRepository()
+	wallets := NewWalletRepository(memWallets, 10, time.Minute)
+	workers := NewWorkerRepository(memWorkers, 10, time.Minute)
+	resolver := NewMinerResolver(memory.NewMinerResolver(memory.NewCoinRepository(map[string]int64{"ALPH": 4}), memWallets, memWorkers), wallets, workers)
+
+	// отрицательный результат попадает в кэш
+	_, err := wallets.GetWalletByName(ctx, "wallet", 4, "PPLNS")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = workers.GetWorkerByName(ctx, "wallet.rig1", 4, "PPLNS")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+
+	ids, err := resolver.ResolveMiners(ctx, []entity.MinerIdentity{
+		{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig1", Worker: "rig1", RewardMethod: "PPLNS"},
+	})
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+
+	// созданные кошелек и воркер находятся сразу, не дожидаясь истечения negativeTTL
+	wallet, err := wallets.GetWalletByName(ctx, "wallet", 4, "PPLNS")
+	require.NoError(t, err)
+	require.Equal(t, ids[0].WalletID, wallet.ID)
+	worker, err := workers.GetWorkerByName(ctx, "wallet.rig1", 4, "PPLNS")
+	require.NoError(t, err)
+	require.Equal(t, ids[0].WorkerID, worker.ID)
+}
@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// coinKey символ в нижнем регистре (поиск без учета регистра) + поиск среди выключенных монет
+type coinKey struct {
+	symbol          string
+	includeInactive bool
+}
+
+// CoinRepository кэширующая обертка над storage.CoinRepository
+// ключ кэша - символ монеты (для проверки существования - ID монеты)
+type CoinRepository struct {
+	next  storage.CoinRepository
+	cache *lru[coinKey, int64]
+	ids   *lru[int64, struct{}]
+}
+
+// NewCoinRepository size - максимальное количество записей в кэше,
+// negativeTTL - время жизни отрицательного результата (0 - не кэшировать)
+func NewCoinRepository(next storage.CoinRepository, size int, negativeTTL time.Duration) *CoinRepository {
+	return &CoinRepository{
+		next:  next,
+		cache: newLRU[coinKey, int64](size, negativeTTL),
+		ids:   newLRU[int64, struct{}](size, negativeTTL),
+	}
+}
+
+func (r *CoinRepository) GetCoinIDBySymbol(ctx context.Context, symbol string, includeInactive bool) (int64, error) {
+	key := coinKey{symbol: strings.ToLower(symbol), includeInactive: includeInactive}
+
+	id, notFound, found := r.cache.get(key)
+	if found {
+		if notFound {
+			return 0, fmt.Errorf("%w: coin %s (cached)", storage.ErrNotFound, symbol)
+		}
+		return id, nil
+	}
+
+	id, err := r.next.GetCoinIDBySymbol(ctx, symbol, includeInactive)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			r.cache.addNotFound(key)
+		}
+		return 0, err
+	}
+	r.cache.add(key, id)
+
+	return id, nil
+}
+
+func (r *CoinRepository) CoinExists(ctx context.Context, id int64) (bool, error) {
+	_, notFound, found := r.ids.get(id)
+	if found {
+		return !notFound, nil
+	}
+
+	exists, err := r.next.CoinExists(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		r.ids.add(id, struct{}{})
+	} else {
+		r.ids.addNotFound(id)
+	}
+
+	return exists, nil
+}
+
+func (r *CoinRepository) ListCoins(ctx context.Context, activeOnly bool) ([]entity.Coin, error) {
+	return r.next.ListCoins(ctx, activeOnly)
+}
+
+func (r *CoinRepository) GetCoin(ctx context.Context, id int64) (entity.Coin, error) {
+	return r.next.GetCoin(ctx, id)
+}
+
+func (r *CoinRepository) CreateCoin(ctx context.Context, coin entity.Coin) (int64, error) {
+	id, err := r.next.CreateCoin(ctx, coin)
+
+	// новая монета могла быть закэширована как отсутствующая (по символу или альтернативному символу)
+	r.cache.purge()
+	if err != nil {
+		return 0, err
+	}
+	r.ids.remove(id)
+
+	return id, nil
+}
+
+func (r *CoinRepository) UpdateCoin(ctx context.Context, coin entity.Coin) error {
+	err := r.next.UpdateCoin(ctx, coin)
+
+	// символ мог измениться, а прежний символ здесь неизвестен - сбрасываем кэш символов целиком
+	// (справочник меняется редко)
+	r.cache.purge()
+
+	return err
+}
+
+func (r *CoinRepository) SetCoinActive(ctx context.Context, id int64, active bool) error {
+	err := r.next.SetCoinActive(ctx, id, active)
+
+	// поиск по символу учитывает активность монеты
+	r.cache.purge()
+
+	return err
+}
+
+func (r *CoinRepository) GetCoinParams(ctx context.Context, id int64) (entity.CoinParams, error) {
+	return r.next.GetCoinParams(ctx, id)
+}
+
+func (r *CoinRepository) PatchCoinParams(ctx context.Context, id int64, patch entity.CoinParamsPatch) (entity.CoinParams, error) {
+	return r.next.PatchCoinParams(ctx, id, patch)
+}
+
+// Stats счетчики обращений к кэшу
+func (r *CoinRepository) Stats() Stats {
+	return r.cache.stats()
+}
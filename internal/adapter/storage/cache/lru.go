@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Stats счетчики обращений к кэшу
+type Stats struct {
+	Hits         uint64 // найдено в кэше (включая отрицательные результаты)
+	NegativeHits uint64 // из них найдено отрицательных результатов ("записи нет")
+	Misses       uint64 // нет в кэше, запрос ушел в хранилище
+	Size         int    // текущее количество записей в кэше
+}
+
+type entry[K comparable, V any] struct {
+	key      K
+	value    V
+	notFound bool      // отрицательный результат (записи в хранилище нет)
+	expires  time.Time // время истечения записи (нулевое - бессрочно)
+}
+
+// lru ограниченный по размеру кэш с вытеснением давно не использованных записей
+// хранит как найденные значения (бессрочно или с ограниченным временем жизни),
+// так и отрицательные результаты (с ограниченным временем жизни)
+type lru[K comparable, V any] struct {
+	mu          sync.Mutex
+	size        int
+	negativeTTL time.Duration
+	ll          *list.List
+	items       map[K]*list.Element
+	now         func() time.Time
+
+	hits         atomic.Uint64
+	negativeHits atomic.Uint64
+	misses       atomic.Uint64
+}
+
+func newLRU[K comparable, V any](size int, negativeTTL time.Duration) *lru[K, V] {
+	return &lru[K, V]{
+		size:        size,
+		negativeTTL: negativeTTL,
+		ll:          list.New(),
+		items:       make(map[K]*list.Element, size),
+		now:         time.Now,
+	}
+}
+
+// get поиск в кэше
+// found - есть ли запись в кэше, notFound - запись в кэше отрицательная (в хранилище значения нет)
+func (c *lru[K, V]) get(key K) (value V, notFound bool, found bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		c.misses.Add(1)
+		return value, false, false
+	}
+
+	e := el.Value.(*entry[K, V])
+	if !e.expires.IsZero() && !c.now().Before(e.expires) {
+		// запись устарела
+		c.removeElement(el)
+		c.misses.Add(1)
+		return value, false, false
+	}
+
+	c.ll.MoveToFront(el)
+	c.hits.Add(1)
+	if e.notFound {
+		c.negativeHits.Add(1)
+	}
+
+	return e.value, e.notFound, true
+}
+
+// add сохранение найденного значения
+func (c *lru[K, V]) add(key K, value V) {
+	c.put(&entry[K, V]{key: key, value: value})
+}
+
+// addWithTTL сохранение найденного значения с ограниченным временем жизни
+func (c *lru[K, V]) addWithTTL(key K, value V, ttl time.Duration) {
+	c.put(&entry[K, V]{key: key, value: value, expires: c.now().Add(ttl)})
+}
+
+// addNotFound сохранение отрицательного результата
+func (c *lru[K, V]) addNotFound(key K) {
+	if c.negativeTTL <= 0 {
+		return
+	}
+	c.put(&entry[K, V]{key: key, notFound: true, expires: c.now().Add(c.negativeTTL)})
+}
+
+// remove удаление записи из кэша
+func (c *lru[K, V]) remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+}
+
+// purge удаление всех записей
+func (c *lru[K, V]) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[K]*list.Element, c.size)
+}
+
+func (c *lru[K, V]) stats() Stats {
+	c.mu.Lock()
+	size := c.ll.Len()
+	c.mu.Unlock()
+
+	return Stats{
+		Hits:         c.hits.Load(),
+		NegativeHits: c.negativeHits.Load(),
+		Misses:       c.misses.Load(),
+		Size:         size,
+	}
+}
+
+func (c *lru[K, V]) put(e *entry[K, V]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[e.key]; ok {
+		el.Value = e
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[e.key] = c.ll.PushFront(e)
+	if c.ll.Len() > c.size {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+func (c *lru[K, V]) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*entry[K, V]).key)
+}
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// MinerResolver обертка над storage.MinerResolver, сбрасывающая записи найденных или созданных кошельков и воркеров
+// в кэшах wallets и workers (nil - кэш не используется), иначе отрицательный результат, закэшированный до создания,
+// отдавался бы до истечения negativeTTL
+type MinerResolver struct {
+	next    storage.MinerResolver
+	wallets *WalletRepository
+	workers *WorkerRepository
+}
+
+func NewMinerResolver(next storage.MinerResolver, wallets *WalletRepository, workers *WorkerRepository) *MinerResolver {
+	return &MinerResolver{
+		next:    next,
+		wallets: wallets,
+		workers: workers,
+	}
+}
+
+func (r *MinerResolver) ResolveMiners(ctx context.Context, miners []entity.MinerIdentity) ([]entity.MinerIDs, error) {
+	ids, err := r.next.ResolveMiners(ctx, miners)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, m := range miners {
+		// неизвестная монета - кошелек и воркер не создавались
+		if i >= len(ids) || ids[i].CoinID == 0 {
+			continue
+		}
+		if r.wallets != nil {
+			r.wallets.cache.remove(walletKey{name: m.Wallet, coinID: ids[i].CoinID, rewardMethod: m.RewardMethod})
+		}
+		if r.workers != nil {
+			r.workers.cache.remove(workerKey{workerfull: m.Workerfull, coinID: ids[i].CoinID, rewardMethod: m.RewardMethod})
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// MinerRemover обертка над storage.MinerRemover, сбрасывающая записи удаленных кошельков и воркеров
+// в кэшах wallets и workers (nil - кэш не используется)
+// кэши других экземпляров сервиса не сбрасываются - удаленное имя находится в них до вытеснения
+type MinerRemover struct {
+	next    storage.MinerRemover
+	wallets *WalletRepository
+	workers *WorkerRepository
+}
+
+func NewMinerRemover(next storage.MinerRemover, wallets *WalletRepository, workers *WorkerRepository) *MinerRemover {
+	return &MinerRemover{
+		next:    next,
+		wallets: wallets,
+		workers: workers,
+	}
+}
+
+func (r *MinerRemover) DeleteWallet(ctx context.Context, walletID int64) (entity.Wallet, []entity.Worker, error) {
+	wallet, workers, err := r.next.DeleteWallet(ctx, walletID)
+	if err != nil {
+		return entity.Wallet{}, nil, err
+	}
+
+	if r.wallets != nil {
+		r.wallets.cache.remove(walletKey{name: wallet.Name, coinID: wallet.CoinID, rewardMethod: wallet.RewardMethod})
+	}
+	for _, w := range workers {
+		r.forgetWorker(w)
+	}
+
+	return wallet, workers, nil
+}
+
+func (r *MinerRemover) DeleteWorker(ctx context.Context, workerID int64) (entity.Worker, error) {
+	worker, err := r.next.DeleteWorker(ctx, workerID)
+	if err != nil {
+		return entity.Worker{}, err
+	}
+	r.forgetWorker(worker)
+
+	return worker, nil
+}
+
+func (r *MinerRemover) MergeWorkers(ctx context.Context, sourceID int64, targetID int64) (entity.Worker, error) {
+	source, err := r.next.MergeWorkers(ctx, sourceID, targetID)
+	if err != nil {
+		return entity.Worker{}, err
+	}
+	r.forgetWorker(source)
+
+	return source, nil
+}
+
+func (r *MinerRemover) forgetWorker(w entity.Worker) {
+	if r.workers != nil {
+		r.workers.cache.remove(workerKey{workerfull: w.Workerfull, coinID: w.CoinID, rewardMethod: w.RewardMethod})
+	}
+}
@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// RewardMethodRepository кэширующая обертка над storage.RewardMethodRepository
+// ключ кэша - ID монеты, записи устаревают через ttl (справочник меняется редко, но без уведомлений)
+type RewardMethodRepository struct {
+	next  storage.RewardMethodRepository
+	ttl   time.Duration
+	cache *lru[int64, []entity.RewardMethodInfo]
+}
+
+func NewRewardMethodRepository(next storage.RewardMethodRepository, size int, ttl time.Duration) *RewardMethodRepository {
+	return &RewardMethodRepository{
+		next:  next,
+		ttl:   ttl,
+		cache: newLRU[int64, []entity.RewardMethodInfo](size, 0),
+	}
+}
+
+func (r *RewardMethodRepository) ListRewardMethodsByCoin(ctx context.Context, coinID int64) ([]entity.RewardMethodInfo, error) {
+	methods, _, found := r.cache.get(coinID)
+	if found {
+		return methods, nil
+	}
+
+	methods, err := r.next.ListRewardMethodsByCoin(ctx, coinID)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.addWithTTL(coinID, methods, r.ttl)
+
+	return methods, nil
+}
+
+// Stats счетчики обращений к кэшу
+func (r *RewardMethodRepository) Stats() Stats {
+	return r.cache.stats()
+}
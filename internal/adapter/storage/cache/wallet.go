@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+type walletKey struct {
+	name         string
+	coinID       int64
+	rewardMethod entity.RewardMethod
+}
+
+// WalletRepository кэширующая обертка над storage.WalletRepository
+// ключ кэша - имя кошелька + ID монеты + метод начисления вознаграждения
+type WalletRepository struct {
+	next  storage.WalletRepository
+	cache *lru[walletKey, entity.Wallet]
+}
+
+// NewWalletRepository size - максимальное количество записей в кэше,
+// negativeTTL - время жизни отрицательного результата (0 - не кэшировать)
+func NewWalletRepository(next storage.WalletRepository, size int, negativeTTL time.Duration) *WalletRepository {
+	return &WalletRepository{
+		next:  next,
+		cache: newLRU[walletKey, entity.Wallet](size, negativeTTL),
+	}
+}
+
+func (r *WalletRepository) GetWalletByName(ctx context.Context, name string, coinID int64, rewardMethod entity.RewardMethod) (entity.Wallet, error) {
+	key := walletKey{name: name, coinID: coinID, rewardMethod: rewardMethod}
+
+	wallet, notFound, found := r.cache.get(key)
+	if found {
+		if notFound {
+			return entity.Wallet{}, fmt.Errorf("%w: wallet %s (cached)", storage.ErrNotFound, name)
+		}
+		return wallet, nil
+	}
+
+	wallet, err := r.next.GetWalletByName(ctx, name, coinID, rewardMethod)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			r.cache.addNotFound(key)
+		}
+		return entity.Wallet{}, err
+	}
+	r.cache.add(key, wallet)
+
+	return wallet, nil
+}
+
+func (r *WalletRepository) CreateWallet(ctx context.Context, wallet entity.Wallet) (int64, error) {
+	key := walletKey{name: wallet.Name, coinID: wallet.CoinID, rewardMethod: wallet.RewardMethod}
+
+	// сбрасываем возможный отрицательный результат еще до записи
+	r.cache.remove(key)
+
+	id, err := r.next.CreateWallet(ctx, wallet)
+	if err != nil {
+		return 0, err
+	}
+
+	// в кэш не кладем: в хранилище могла остаться ранее созданная запись с другими данными,
+	// при следующем чтении она будет загружена целиком
+	return id, nil
+}
+
+// ListWallets списки не кэшируются
+func (r *WalletRepository) ListWallets(ctx context.Context, filter storage.WalletFilter, page storage.WalletPage) ([]entity.Wallet, error) {
+	return r.next.ListWallets(ctx, filter, page)
+}
+
+// GetWallet не кэшируется (кэш хранит только ID по имени кошелька)
+func (r *WalletRepository) GetWallet(ctx context.Context, id int64) (entity.Wallet, error) {
+	return r.next.GetWallet(ctx, id)
+}
+
+// SetPaymentThreshold порог выплаты в кэше не хранится
+func (r *WalletRepository) SetPaymentThreshold(ctx context.Context, change entity.PaymentThresholdChange) (entity.PaymentThresholdChange, error) {
+	return r.next.SetPaymentThreshold(ctx, change)
+}
+
+func (r *WalletRepository) ListPaymentThresholdChanges(ctx context.Context, walletID int64, limit int) ([]entity.PaymentThresholdChange, error) {
+	return r.next.ListPaymentThresholdChanges(ctx, walletID, limit)
+}
+
+// Stats счетчики обращений к кэшу
+func (r *WalletRepository) Stats() Stats {
+	return r.cache.stats()
+}
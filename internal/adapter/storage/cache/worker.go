@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+type workerKey struct {
+	workerfull   string
+	coinID       int64
+	rewardMethod entity.RewardMethod
+}
+
+// WorkerRepository кэширующая обертка над storage.WorkerRepository
+// ключ кэша - полное имя воркера + ID монеты + метод начисления вознаграждения
+type WorkerRepository struct {
+	next  storage.WorkerRepository
+	cache *lru[workerKey, entity.Worker]
+}
+
+// NewWorkerRepository size - максимальное количество записей в кэше,
+// negativeTTL - время жизни отрицательного результата (0 - не кэшировать)
+func NewWorkerRepository(next storage.WorkerRepository, size int, negativeTTL time.Duration) *WorkerRepository {
+	return &WorkerRepository{
+		next:  next,
+		cache: newLRU[workerKey, entity.Worker](size, negativeTTL),
+	}
+}
+
+func (r *WorkerRepository) GetWorkerByName(ctx context.Context, workerfull string, coinID int64, rewardMethod entity.RewardMethod) (entity.Worker, error) {
+	key := workerKey{workerfull: workerfull, coinID: coinID, rewardMethod: rewardMethod}
+
+	worker, notFound, found := r.cache.get(key)
+	if found {
+		if notFound {
+			return entity.Worker{}, fmt.Errorf("%w: worker %s (cached)", storage.ErrNotFound, workerfull)
+		}
+		return worker, nil
+	}
+
+	worker, err := r.next.GetWorkerByName(ctx, workerfull, coinID, rewardMethod)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			r.cache.addNotFound(key)
+		}
+		return entity.Worker{}, err
+	}
+	r.cache.add(key, worker)
+
+	return worker, nil
+}
+
+func (r *WorkerRepository) CreateWorker(ctx context.Context, worker entity.Worker) (int64, error) {
+	key := workerKey{workerfull: worker.Workerfull, coinID: worker.CoinID, rewardMethod: worker.RewardMethod}
+
+	// сбрасываем возможный отрицательный результат еще до записи
+	r.cache.remove(key)
+
+	id, err := r.next.CreateWorker(ctx, worker)
+	if err != nil {
+		return 0, err
+	}
+
+	// в кэш не кладем: в хранилище могла остаться ранее созданная запись с другими данными (ip, server_id),
+	// при следующем чтении она будет загружена целиком
+	return id, nil
+}
+
+// GetWorkerIPHistory история не кэшируется (меняется при каждом переподключении)
+func (r *WorkerRepository) GetWorkerIPHistory(ctx context.Context, workerID int64, limit int) ([]entity.WorkerIP, error) {
+	return r.next.GetWorkerIPHistory(ctx, workerID, limit)
+}
+
+// ListWorkersByWallet списки не кэшируются (состояние воркеров постоянно меняется)
+func (r *WorkerRepository) ListWorkersByWallet(ctx context.Context, filter storage.WorkerFilter, page storage.WorkerPage) ([]entity.Worker, error) {
+	return r.next.ListWorkersByWallet(ctx, filter, page)
+}
+
+// Stats счетчики обращений к кэшу
+func (r *WorkerRepository) Stats() Stats {
+	return r.cache.stats()
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// WalletSort поле сортировки списка кошельков (при равенстве значений - по ID)
+type WalletSort string
+
+const (
+	WalletSortID              WalletSort = "id"
+	WalletSortName            WalletSort = "name"
+	WalletSortCurrentHashrate WalletSort = "current_hashrate"
+	WalletSortAverageHashrate WalletSort = "average_hashrate"
+	WalletSortCreatedAt       WalletSort = "created_at"
+)
+
+// WalletFilter условия отбора кошельков (нулевые значения - без ограничения)
+type WalletFilter struct {
+	CoinID       int64
+	RewardMethod entity.RewardMethod
+	NamePrefix   string
+	MinHashrate  *int64    // текущий хешрейт от (включительно)
+	MaxHashrate  *int64    // текущий хешрейт до (включительно)
+	CreatedFrom  time.Time // создан не раньше (включительно)
+	CreatedTo    time.Time // создан раньше (не включительно)
+}
+
+// WalletPage страница списка кошельков (keyset пагинация)
+type WalletPage struct {
+	Sort  WalletSort
+	Desc  bool
+	Limit int
+	After *entity.Wallet // последний кошелек предыдущей страницы (значимы ID и поле сортировки), nil - первая страница
+}
+
+// WorkerFilter условия отбора воркеров кошелька
+type WorkerFilter struct {
+	Wallet       string
+	CoinID       int64
+	RewardMethod entity.RewardMethod
+	Online       *bool // true - только подключенные, false - только отключенные, nil - все
+}
+
+// WorkerPage страница списка воркеров (keyset пагинация по ID)
+type WorkerPage struct {
+	Limit   int
+	AfterID int64 // ID последнего воркера предыдущей страницы, 0 - первая страница
+}
@@ -0,0 +1,190 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// CoinRepository реализация storage.CoinRepository в памяти (для тестов)
+type CoinRepository struct {
+	mu     sync.RWMutex
+	lastID int64
+	coins  map[int64]entity.Coin // ID => монета
+}
+
+// NewCoinRepository coins - начальное заполнение справочника (символ монеты => ID), монеты активны
+func NewCoinRepository(coins map[string]int64) *CoinRepository {
+	r := &CoinRepository{
+		coins: make(map[int64]entity.Coin, len(coins)),
+	}
+	for symbol, id := range coins {
+		r.coins[id] = entity.Coin{ID: id, Symbol: symbol, IsActive: true}
+		if id > r.lastID {
+			r.lastID = id
+		}
+	}
+
+	return r
+}
+
+func (r *CoinRepository) GetCoinIDBySymbol(ctx context.Context, symbol string, includeInactive bool) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var aliasID int64
+	for id, c := range r.coins {
+		if !c.IsActive && !includeInactive {
+			continue
+		}
+		if strings.EqualFold(c.Symbol, symbol) {
+			return id, nil
+		}
+		if c.Symbol2 != "" && strings.EqualFold(c.Symbol2, symbol) {
+			aliasID = id
+		}
+	}
+	if aliasID > 0 {
+		return aliasID, nil
+	}
+
+	return 0, storage.ErrNotFound
+}
+
+func (r *CoinRepository) CoinExists(ctx context.Context, id int64) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.coins[id]
+
+	return ok, nil
+}
+
+func (r *CoinRepository) ListCoins(ctx context.Context, activeOnly bool) ([]entity.Coin, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	coins := make([]entity.Coin, 0, len(r.coins))
+	for _, c := range r.coins {
+		if activeOnly && !c.IsActive {
+			continue
+		}
+		coins = append(coins, c)
+	}
+	sort.Slice(coins, func(i, j int) bool {
+		return coins[i].ID < coins[j].ID
+	})
+
+	return coins, nil
+}
+
+func (r *CoinRepository) GetCoin(ctx context.Context, id int64) (entity.Coin, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.coins[id]
+	if !ok {
+		return entity.Coin{}, storage.ErrNotFound
+	}
+
+	return c, nil
+}
+
+func (r *CoinRepository) CreateCoin(ctx context.Context, coin entity.Coin) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.symbolTaken(coin, 0) {
+		return 0, fmt.Errorf("%w: coin %s", storage.ErrAlreadyExists, coin.Symbol)
+	}
+
+	r.lastID++
+	coin.ID = r.lastID
+	r.coins[coin.ID] = coin
+
+	return coin.ID, nil
+}
+
+func (r *CoinRepository) UpdateCoin(ctx context.Context, coin entity.Coin) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.coins[coin.ID]
+	if !ok {
+		return storage.ErrNotFound
+	}
+	if r.symbolTaken(coin, coin.ID) {
+		return fmt.Errorf("%w: coin %s", storage.ErrAlreadyExists, coin.Symbol)
+	}
+
+	existing.Symbol, existing.Symbol2, existing.Name, existing.Algo, existing.Image = coin.Symbol, coin.Symbol2, coin.Name, coin.Algo, coin.Image
+	existing.MinWithdraw, existing.TransactionsExplorer, existing.BlockExplorer = coin.MinWithdraw, coin.TransactionsExplorer, coin.BlockExplorer
+	existing.CoinsInBlock, existing.SeoTitle, existing.MaxPaymentThreshold = coin.CoinsInBlock, coin.SeoTitle, coin.MaxPaymentThreshold
+	r.coins[coin.ID] = existing
+
+	return nil
+}
+
+func (r *CoinRepository) SetCoinActive(ctx context.Context, id int64, active bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.coins[id]
+	if !ok {
+		return storage.ErrNotFound
+	}
+	c.IsActive = active
+	r.coins[id] = c
+
+	return nil
+}
+
+func (r *CoinRepository) GetCoinParams(ctx context.Context, id int64) (entity.CoinParams, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.coins[id]
+	if !ok {
+		return entity.CoinParams{}, storage.ErrNotFound
+	}
+
+	return c.Params, nil
+}
+
+func (r *CoinRepository) PatchCoinParams(ctx context.Context, id int64, patch entity.CoinParamsPatch) (entity.CoinParams, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.coins[id]
+	if !ok {
+		return entity.CoinParams{}, storage.ErrNotFound
+	}
+	c.Params = patch.Apply(c.Params)
+	r.coins[id] = c
+
+	return c.Params, nil
+}
+
+// symbolTaken символ или альтернативный символ занят монетой, отличной от exceptID, без учета регистра
+// (вызывается под блокировкой)
+func (r *CoinRepository) symbolTaken(coin entity.Coin, exceptID int64) bool {
+	for id, c := range r.coins {
+		if id == exceptID {
+			continue
+		}
+		for _, taken := range []string{c.Symbol, c.Symbol2} {
+			if taken == "" {
+				continue
+			}
+			if strings.EqualFold(taken, coin.Symbol) || (coin.Symbol2 != "" && strings.EqualFold(taken, coin.Symbol2)) {
+				return true
+			}
+		}
+	}
+	return false
+}
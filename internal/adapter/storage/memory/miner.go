@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// MinerResolver реализация storage.MinerResolver поверх репозиториев в памяти (для тестов)
+type MinerResolver struct {
+	coins   *CoinRepository
+	wallets *WalletRepository
+	workers *WorkerRepository
+}
+
+func NewMinerResolver(coins *CoinRepository, wallets *WalletRepository, workers *WorkerRepository) *MinerResolver {
+	return &MinerResolver{
+		coins:   coins,
+		wallets: wallets,
+		workers: workers,
+	}
+}
+
+func (r *MinerResolver) ResolveMiners(ctx context.Context, miners []entity.MinerIdentity) ([]entity.MinerIDs, error) {
+	result := make([]entity.MinerIDs, len(miners))
+
+	for i, m := range miners {
+		coinID, err := r.coins.GetCoinIDBySymbol(ctx, m.Coin, false)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		walletID, err := r.wallets.CreateWallet(ctx, entity.Wallet{
+			CoinID:       coinID,
+			Name:         m.Wallet,
+			RewardMethod: m.RewardMethod,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		workerID, err := r.workers.CreateWorker(ctx, entity.Worker{
+			CoinID:       coinID,
+			Workerfull:   m.Workerfull,
+			Wallet:       m.Wallet,
+			Worker:       m.Worker,
+			ServerID:     m.ServerID,
+			IP:           m.IP,
+			RewardMethod: m.RewardMethod,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = entity.MinerIDs{CoinID: coinID, WalletID: walletID, WorkerID: workerID}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// MinerRemover реализация storage.MinerRemover поверх репозиториев в памяти (для тестов)
+// удаленные записи убираются из репозиториев (в памяти журналы на них не ссылаются)
+type MinerRemover struct {
+	wallets *WalletRepository
+	workers *WorkerRepository
+}
+
+func NewMinerRemover(wallets *WalletRepository, workers *WorkerRepository) *MinerRemover {
+	return &MinerRemover{
+		wallets: wallets,
+		workers: workers,
+	}
+}
+
+func (r *MinerRemover) DeleteWallet(ctx context.Context, walletID int64) (entity.Wallet, []entity.Worker, error) {
+	r.wallets.mu.Lock()
+	var wallet entity.Wallet
+	found := false
+	for key, w := range r.wallets.wallets {
+		if w.ID == walletID {
+			wallet, found = w, true
+			delete(r.wallets.wallets, key)
+			break
+		}
+	}
+	r.wallets.mu.Unlock()
+	if !found {
+		return entity.Wallet{}, nil, storage.ErrNotFound
+	}
+
+	r.workers.mu.Lock()
+	defer r.workers.mu.Unlock()
+
+	workers := make([]entity.Worker, 0)
+	for key, w := range r.workers.workers {
+		if w.Wallet == wallet.Name && w.CoinID == wallet.CoinID && w.RewardMethod == wallet.RewardMethod {
+			workers = append(workers, w)
+			r.workers.remove(key)
+		}
+	}
+	sort.Slice(workers, func(i, j int) bool {
+		return workers[i].ID < workers[j].ID
+	})
+
+	return wallet, workers, nil
+}
+
+func (r *MinerRemover) DeleteWorker(ctx context.Context, workerID int64) (entity.Worker, error) {
+	r.workers.mu.Lock()
+	key, worker, ok := r.workers.byID(workerID)
+	if !ok {
+		r.workers.mu.Unlock()
+		return entity.Worker{}, storage.ErrNotFound
+	}
+	r.workers.remove(key)
+	r.workers.mu.Unlock()
+
+	r.rollup(worker)
+
+	return worker, nil
+}
+
+func (r *MinerRemover) MergeWorkers(ctx context.Context, sourceID int64, targetID int64) (entity.Worker, error) {
+	r.workers.mu.Lock()
+	defer r.workers.mu.Unlock()
+
+	sourceKey, source, ok := r.workers.byID(sourceID)
+	if !ok {
+		return entity.Worker{}, storage.ErrNotFound
+	}
+	targetKey, target, ok := r.workers.byID(targetID)
+	if !ok {
+		return entity.Worker{}, storage.ErrNotFound
+	}
+	if source.Wallet != target.Wallet || source.CoinID != target.CoinID || source.RewardMethod != target.RewardMethod {
+		return entity.Worker{}, storage.ErrWorkersMismatch
+	}
+
+	for _, h := range r.workers.history[sourceID] {
+		merged := false
+		for i, t := range r.workers.history[targetID] {
+			if t.IP != h.IP {
+				continue
+			}
+			if h.FirstSeen.Before(t.FirstSeen) {
+				r.workers.history[targetID][i].FirstSeen = h.FirstSeen
+			}
+			if h.LastSeen.After(t.LastSeen) {
+				r.workers.history[targetID][i].LastSeen = h.LastSeen
+			}
+			merged = true
+		}
+		if !merged {
+			r.workers.history[targetID] = append(r.workers.history[targetID], h)
+		}
+	}
+
+	target.CurrentHashrate += source.CurrentHashrate
+	target.AverageHashrate += source.AverageHashrate
+	target.IsConnect = target.IsConnect || source.IsConnect
+	if source.LastShareDate.After(target.LastShareDate) {
+		target.LastShareDate = source.LastShareDate
+	}
+	r.workers.workers[targetKey] = target
+	r.workers.remove(sourceKey)
+
+	return source, nil
+}
+
+// rollup пересчет хешрейта кошелька воркера по оставшимся воркерам
+func (r *MinerRemover) rollup(worker entity.Worker) {
+	r.workers.mu.RLock()
+	var current, average int64
+	for _, w := range r.workers.workers {
+		if w.Wallet == worker.Wallet && w.CoinID == worker.CoinID && w.RewardMethod == worker.RewardMethod {
+			current += w.CurrentHashrate
+			average += w.AverageHashrate
+		}
+	}
+	r.workers.mu.RUnlock()
+
+	r.wallets.mu.Lock()
+	defer r.wallets.mu.Unlock()
+	key := walletKey{name: worker.Wallet, coinID: worker.CoinID, rewardMethod: worker.RewardMethod}
+	if wallet, ok := r.wallets.wallets[key]; ok {
+		wallet.CurrentHashrate = current
+		wallet.AverageHashrate = average
+		r.wallets.wallets[key] = wallet
+	}
+}
@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// RewardMethodRepository реализация storage.RewardMethodRepository в памяти (для тестов)
+type RewardMethodRepository struct {
+	mu      sync.RWMutex
+	methods map[int64][]entity.RewardMethod // ID монеты => доступные методы
+}
+
+// NewRewardMethodRepository methods - начальное заполнение (ID монеты => доступные методы)
+func NewRewardMethodRepository(methods map[int64][]entity.RewardMethod) *RewardMethodRepository {
+	r := &RewardMethodRepository{
+		methods: make(map[int64][]entity.RewardMethod, len(methods)),
+	}
+	for coinID, m := range methods {
+		r.methods[coinID] = append([]entity.RewardMethod(nil), m...)
+	}
+
+	return r
+}
+
+func (r *RewardMethodRepository) ListRewardMethodsByCoin(ctx context.Context, coinID int64) ([]entity.RewardMethodInfo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	methods := make([]entity.RewardMethodInfo, 0, len(r.methods[coinID]))
+	for _, m := range r.methods[coinID] {
+		methods = append(methods, entity.RewardMethodInfo{Code: m, Name: string(m)})
+	}
+
+	return methods, nil
+}
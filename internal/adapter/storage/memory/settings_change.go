@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// SettingsChangeRepository реализация storage.SettingsChangeRepository поверх репозиториев в памяти (для тестов)
+type SettingsChangeRepository struct {
+	mu      sync.Mutex
+	coins   *CoinRepository
+	wallets *WalletRepository
+	changes []settingsChange
+}
+
+// settingsChange запись изменения с признаком отмены
+type settingsChange struct {
+	entity.SettingsChange
+	cancelled bool
+}
+
+func NewSettingsChangeRepository(coins *CoinRepository, wallets *WalletRepository) *SettingsChangeRepository {
+	return &SettingsChangeRepository{
+		coins:   coins,
+		wallets: wallets,
+	}
+}
+
+func (r *SettingsChangeRepository) CreateSettingsChange(ctx context.Context, change entity.SettingsChange) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.wallets.GetWallet(ctx, change.WalletID); err != nil {
+		return 0, err
+	}
+
+	for i, c := range r.changes {
+		if c.WalletID == change.WalletID && c.Kind == change.Kind && c.ConfirmedAt.IsZero() {
+			r.changes[i].cancelled = true
+		}
+	}
+
+	change.ID = int64(len(r.changes) + 1)
+	change.CreatedAt = time.Now()
+	r.changes = append(r.changes, settingsChange{SettingsChange: change})
+
+	return change.ID, nil
+}
+
+func (r *SettingsChangeRepository) ConfirmSettingsChange(ctx context.Context, tokenHash string, service string) (entity.SettingsChange, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for i, c := range r.changes {
+		if c.TokenHash != tokenHash || c.cancelled || !c.ConfirmedAt.IsZero() || !c.ExpiresAt.After(now) {
+			continue
+		}
+
+		change := c.SettingsChange
+		switch change.Kind {
+		case entity.SettingsKindPaymentThreshold:
+			wallet, err := r.wallets.GetWallet(ctx, change.WalletID)
+			if err != nil {
+				return entity.SettingsChange{}, err
+			}
+			coin, err := r.coins.GetCoin(ctx, wallet.CoinID)
+			if err != nil {
+				return entity.SettingsChange{}, err
+			}
+			threshold, err := entity.ParseDecimal(change.Value)
+			if err != nil {
+				return entity.SettingsChange{}, fmt.Errorf("%w: %v", entity.ErrPaymentThresholdOutOfRange, err)
+			}
+			if err := entity.ValidatePaymentThreshold(threshold, coin); err != nil {
+				return entity.SettingsChange{}, err
+			}
+
+			applied, err := r.wallets.SetPaymentThreshold(ctx, entity.PaymentThresholdChange{
+				WalletID: change.WalletID,
+				NewValue: threshold,
+				Service:  service,
+				Actor:    change.Actor,
+			})
+			if err != nil {
+				return entity.SettingsChange{}, err
+			}
+			change.Value = applied.NewValue.String()
+
+		default:
+			return entity.SettingsChange{}, fmt.Errorf("unknown settings kind %q", change.Kind)
+		}
+
+		change.ConfirmedAt = now
+		r.changes[i].ConfirmedAt = now
+
+		return change, nil
+	}
+
+	return entity.SettingsChange{}, storage.ErrNotFound
+}
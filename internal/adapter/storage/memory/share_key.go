@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ShareKeyRepository реализация storage.ShareKeyRepository в памяти (для тестов)
+type ShareKeyRepository struct {
+	mu   sync.Mutex
+	keys map[string]time.Time // ключ => время записи
+}
+
+func NewShareKeyRepository() *ShareKeyRepository {
+	return &ShareKeyRepository{
+		keys: make(map[string]time.Time),
+	}
+}
+
+func (r *ShareKeyRepository) ClaimShareKeys(ctx context.Context, keys []string, seenAt time.Time, since time.Time) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	claimed := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if prev, ok := r.keys[key]; ok && !prev.Before(since) {
+			continue
+		}
+		r.keys[key] = seenAt
+		claimed = append(claimed, key)
+	}
+
+	return claimed, nil
+}
+
+func (r *ShareKeyRepository) ReleaseShareKeys(ctx context.Context, keys []string, seenAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, key := range keys {
+		if prev, ok := r.keys[key]; ok && prev.Equal(seenAt) {
+			delete(r.keys, key)
+		}
+	}
+
+	return nil
+}
+
+func (r *ShareKeyRepository) DeleteShareKeys(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for key, seenAt := range r.keys {
+		if seenAt.Before(before) {
+			delete(r.keys, key)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
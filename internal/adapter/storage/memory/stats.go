@@ -0,0 +1,153 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// WorkerStatsUpdater реализация storage.WorkerStatsUpdater поверх репозиториев в памяти (для тестов)
+type WorkerStatsUpdater struct {
+	wallets *WalletRepository
+	workers *WorkerRepository
+}
+
+func NewWorkerStatsUpdater(wallets *WalletRepository, workers *WorkerRepository) *WorkerStatsUpdater {
+	return &WorkerStatsUpdater{
+		wallets: wallets,
+		workers: workers,
+	}
+}
+
+func (u *WorkerStatsUpdater) UpdateWorkerStats(ctx context.Context, stats []entity.WorkerStats) (int, []entity.WorkerStatusEvent, error) {
+	u.workers.mu.Lock()
+	now := u.workers.now()
+
+	byID := make(map[int64]workerKey, len(u.workers.workers))
+	for key, w := range u.workers.workers {
+		byID[w.ID] = key
+	}
+
+	updatedIDs := make(map[int64]struct{})
+	touched := make(map[walletKey]struct{})
+	wasConnect := make(map[int64]bool) // состояние до изменения
+	for _, s := range stats {
+		key, ok := byID[s.WorkerID]
+		if !ok {
+			continue
+		}
+		w := u.workers.workers[key]
+		if _, ok := wasConnect[w.ID]; !ok {
+			wasConnect[w.ID] = w.IsConnect
+		}
+		w.IsConnect = s.IsConnect
+		w.CurrentHashrate = s.CurrentHashrate
+		w.AverageHashrate = s.AverageHashrate
+		if !s.LastShareDate.IsZero() {
+			w.LastShareDate = s.LastShareDate
+		}
+		if s.CurrentDiff != nil {
+			w.CurrentDiff = s.CurrentDiff.Rescale(entity.CurrentDiffScale)
+		}
+		if s.ReportedHashrate != nil {
+			w.ReportedHashrate = *s.ReportedHashrate
+			w.ReportedHashrateDate = now
+		}
+		u.workers.workers[key] = w
+		u.workers.updated[w.ID] = now
+
+		updatedIDs[w.ID] = struct{}{}
+		touched[walletKey{name: w.Wallet, coinID: w.CoinID, rewardMethod: w.RewardMethod}] = struct{}{}
+	}
+
+	events := make([]entity.WorkerStatusEvent, 0)
+	for _, w := range u.workers.workers {
+		if was, ok := wasConnect[w.ID]; ok && was != w.IsConnect {
+			events = append(events, statusEvent(w, w.IsConnect, now))
+		}
+	}
+
+	// сводка по кошелькам затронутых воркеров
+	current, average := u.walletHashrates(touched)
+	u.workers.mu.Unlock()
+
+	u.setWalletHashrates(touched, current, average)
+
+	return len(updatedIDs), events, nil
+}
+
+func (u *WorkerStatsUpdater) MarkWorkersOffline(ctx context.Context, coinID int64, silentSince time.Time) ([]entity.WorkerStatusEvent, error) {
+	u.workers.mu.Lock()
+
+	now := u.workers.now()
+	events := make([]entity.WorkerStatusEvent, 0)
+	touched := make(map[walletKey]struct{})
+	for key, w := range u.workers.workers {
+		if w.CoinID != coinID || !w.IsConnect {
+			continue
+		}
+		lastSeen := w.LastShareDate
+		if lastSeen.IsZero() {
+			lastSeen = u.workers.updated[w.ID]
+		}
+		if !lastSeen.Before(silentSince) {
+			continue
+		}
+
+		w.IsConnect = false
+		w.CurrentHashrate = 0
+		u.workers.workers[key] = w
+		u.workers.updated[w.ID] = now
+		events = append(events, statusEvent(w, false, now))
+		touched[walletKey{name: w.Wallet, coinID: w.CoinID, rewardMethod: w.RewardMethod}] = struct{}{}
+	}
+
+	current, average := u.walletHashrates(touched)
+	u.workers.mu.Unlock()
+
+	u.setWalletHashrates(touched, current, average)
+
+	return events, nil
+}
+
+// walletHashrates суммы хешрейта воркеров кошельков (вызывается под блокировкой воркеров)
+func (u *WorkerStatsUpdater) walletHashrates(wallets map[walletKey]struct{}) (map[walletKey]int64, map[walletKey]int64) {
+	current := make(map[walletKey]int64, len(wallets))
+	average := make(map[walletKey]int64, len(wallets))
+	for _, w := range u.workers.workers {
+		key := walletKey{name: w.Wallet, coinID: w.CoinID, rewardMethod: w.RewardMethod}
+		if _, ok := wallets[key]; ok {
+			current[key] += w.CurrentHashrate
+			average[key] += w.AverageHashrate
+		}
+	}
+
+	return current, average
+}
+
+func (u *WorkerStatsUpdater) setWalletHashrates(wallets map[walletKey]struct{}, current map[walletKey]int64, average map[walletKey]int64) {
+	u.wallets.mu.Lock()
+	defer u.wallets.mu.Unlock()
+
+	for key := range wallets {
+		if wallet, ok := u.wallets.wallets[key]; ok {
+			wallet.CurrentHashrate = current[key]
+			wallet.AverageHashrate = average[key]
+			u.wallets.wallets[key] = wallet
+		}
+	}
+}
+
+func statusEvent(w entity.Worker, online bool, at time.Time) entity.WorkerStatusEvent {
+	return entity.WorkerStatusEvent{
+		WorkerID:      w.ID,
+		CoinID:        w.CoinID,
+		Wallet:        w.Wallet,
+		Workerfull:    w.Workerfull,
+		RewardMethod:  w.RewardMethod,
+		Online:        online,
+		LastShareDate: w.LastShareDate,
+		Time:          at,
+	}
+}
@@ -0,0 +1,187 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+type walletKey struct {
+	name         string
+	coinID       int64
+	rewardMethod entity.RewardMethod
+}
+
+// WalletRepository реализация storage.WalletRepository в памяти (для тестов)
+type WalletRepository struct {
+	mu         sync.RWMutex
+	lastID     int64
+	wallets    map[walletKey]entity.Wallet
+	thresholds []entity.PaymentThresholdChange // журнал изменений порога выплаты (в порядке записи)
+}
+
+func NewWalletRepository() *WalletRepository {
+	return &WalletRepository{
+		wallets: make(map[walletKey]entity.Wallet),
+	}
+}
+
+func (r *WalletRepository) GetWalletByName(ctx context.Context, name string, coinID int64, rewardMethod entity.RewardMethod) (entity.Wallet, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	w, ok := r.wallets[walletKey{name: name, coinID: coinID, rewardMethod: rewardMethod}]
+	if !ok {
+		return entity.Wallet{}, storage.ErrNotFound
+	}
+
+	return w, nil
+}
+
+func (r *WalletRepository) CreateWallet(ctx context.Context, wallet entity.Wallet) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := walletKey{name: wallet.Name, coinID: wallet.CoinID, rewardMethod: wallet.RewardMethod}
+	if existing, ok := r.wallets[key]; ok {
+		return existing.ID, nil
+	}
+
+	r.lastID++
+	wallet.ID = r.lastID
+	if wallet.CreatedAt.IsZero() {
+		wallet.CreatedAt = time.Now().UTC().Truncate(time.Millisecond)
+	}
+	wallet.PaymentThreshold = wallet.PaymentThreshold.Rescale(entity.PaymentThresholdScale) // как в колонке numeric(20,6)
+	r.wallets[key] = wallet
+
+	return wallet.ID, nil
+}
+
+func (r *WalletRepository) ListWallets(ctx context.Context, filter storage.WalletFilter, page storage.WalletPage) ([]entity.Wallet, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	wallets := make([]entity.Wallet, 0)
+	for _, w := range r.wallets {
+		if filter.CoinID > 0 && w.CoinID != filter.CoinID ||
+			filter.RewardMethod != "" && w.RewardMethod != filter.RewardMethod ||
+			!strings.HasPrefix(w.Name, filter.NamePrefix) ||
+			filter.MinHashrate != nil && w.CurrentHashrate < *filter.MinHashrate ||
+			filter.MaxHashrate != nil && w.CurrentHashrate > *filter.MaxHashrate ||
+			!filter.CreatedFrom.IsZero() && w.CreatedAt.Before(filter.CreatedFrom) ||
+			!filter.CreatedTo.IsZero() && !w.CreatedAt.Before(filter.CreatedTo) {
+			continue
+		}
+		if page.After != nil && !walletAfter(w, *page.After, page.Sort, page.Desc) {
+			continue
+		}
+		wallets = append(wallets, w)
+	}
+
+	sort.Slice(wallets, func(i, j int) bool {
+		return walletAfter(wallets[j], wallets[i], page.Sort, page.Desc)
+	})
+	if page.Limit > 0 && len(wallets) > page.Limit {
+		wallets = wallets[:page.Limit]
+	}
+
+	return wallets, nil
+}
+
+func (r *WalletRepository) GetWallet(ctx context.Context, id int64) (entity.Wallet, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, w := range r.wallets {
+		if w.ID == id {
+			return w, nil
+		}
+	}
+
+	return entity.Wallet{}, storage.ErrNotFound
+}
+
+func (r *WalletRepository) SetPaymentThreshold(ctx context.Context, change entity.PaymentThresholdChange) (entity.PaymentThresholdChange, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, w := range r.wallets {
+		if w.ID != change.WalletID {
+			continue
+		}
+
+		change.OldValue = w.PaymentThreshold
+		change.NewValue = change.NewValue.Rescale(entity.PaymentThresholdScale)
+		change.ChangedAt = time.Now()
+		if change.OldValue.Equal(change.NewValue) {
+			return change, nil
+		}
+
+		w.PaymentThreshold = change.NewValue
+		r.wallets[key] = w
+		change.ID = int64(len(r.thresholds) + 1)
+		r.thresholds = append(r.thresholds, change)
+
+		return change, nil
+	}
+
+	return entity.PaymentThresholdChange{}, storage.ErrNotFound
+}
+
+func (r *WalletRepository) ListPaymentThresholdChanges(ctx context.Context, walletID int64, limit int) ([]entity.PaymentThresholdChange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	changes := make([]entity.PaymentThresholdChange, 0)
+	for i := len(r.thresholds) - 1; i >= 0 && len(changes) < limit; i-- {
+		if r.thresholds[i].WalletID == walletID {
+			changes = append(changes, r.thresholds[i])
+		}
+	}
+
+	return changes, nil
+}
+
+// walletAfter кошелек w следует за after в порядке сортировки
+func walletAfter(w entity.Wallet, after entity.Wallet, sort storage.WalletSort, desc bool) bool {
+	c := compareWallets(w, after, sort)
+	if desc {
+		return c < 0
+	}
+	return c > 0
+}
+
+// compareWallets сравнение по полю сортировки, затем по ID
+func compareWallets(a entity.Wallet, b entity.Wallet, sort storage.WalletSort) int {
+	c := 0
+	switch sort {
+	case storage.WalletSortName:
+		c = strings.Compare(a.Name, b.Name)
+	case storage.WalletSortCurrentHashrate:
+		c = compareInt64(a.CurrentHashrate, b.CurrentHashrate)
+	case storage.WalletSortAverageHashrate:
+		c = compareInt64(a.AverageHashrate, b.AverageHashrate)
+	case storage.WalletSortCreatedAt:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if c != 0 {
+		return c
+	}
+	return compareInt64(a.ID, b.ID)
+}
+
+func compareInt64(a int64, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,156 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+type workerKey struct {
+	workerfull   string
+	coinID       int64
+	rewardMethod entity.RewardMethod
+}
+
+// WorkerRepository реализация storage.WorkerRepository в памяти (для тестов)
+type WorkerRepository struct {
+	mu      sync.RWMutex
+	lastID  int64
+	workers map[workerKey]entity.Worker
+	history map[int64][]entity.WorkerIP // ID воркера => адреса подключения
+	updated map[int64]time.Time         // ID воркера => время создания или последнего обновления состояния
+	now     func() time.Time
+}
+
+func NewWorkerRepository() *WorkerRepository {
+	return &WorkerRepository{
+		workers: make(map[workerKey]entity.Worker),
+		history: make(map[int64][]entity.WorkerIP),
+		updated: make(map[int64]time.Time),
+		now:     time.Now,
+	}
+}
+
+func (r *WorkerRepository) GetWorkerByName(ctx context.Context, workerfull string, coinID int64, rewardMethod entity.RewardMethod) (entity.Worker, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	w, ok := r.workers[workerKey{workerfull: workerfull, coinID: coinID, rewardMethod: rewardMethod}]
+	if !ok {
+		return entity.Worker{}, storage.ErrNotFound
+	}
+
+	return w, nil
+}
+
+func (r *WorkerRepository) CreateWorker(ctx context.Context, worker entity.Worker) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := workerKey{workerfull: worker.Workerfull, coinID: worker.CoinID, rewardMethod: worker.RewardMethod}
+	if existing, ok := r.workers[key]; ok {
+		existing.ServerID = worker.ServerID
+		if worker.IP != "" {
+			existing.IP = worker.IP
+			r.touchIP(existing.ID, worker.IP)
+		}
+		r.workers[key] = existing
+		r.updated[existing.ID] = r.now()
+		return existing.ID, nil
+	}
+
+	r.lastID++
+	worker.ID = r.lastID
+	worker.CurrentDiff = worker.CurrentDiff.Rescale(entity.CurrentDiffScale)
+	r.workers[key] = worker
+	r.history[worker.ID] = make([]entity.WorkerIP, 0)
+	r.updated[worker.ID] = r.now()
+	if worker.IP != "" {
+		r.touchIP(worker.ID, worker.IP)
+	}
+
+	return worker.ID, nil
+}
+
+func (r *WorkerRepository) GetWorkerIPHistory(ctx context.Context, workerID int64, limit int) ([]entity.WorkerIP, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history, ok := r.history[workerID]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+
+	// в обратном порядке добавления - при одинаковом времени первым будет более новый адрес
+	result := make([]entity.WorkerIP, len(history))
+	for i, h := range history {
+		result[len(history)-1-i] = h
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].LastSeen.After(result[j].LastSeen)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
+func (r *WorkerRepository) ListWorkersByWallet(ctx context.Context, filter storage.WorkerFilter, page storage.WorkerPage) ([]entity.Worker, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	workers := make([]entity.Worker, 0)
+	for _, w := range r.workers {
+		if w.Wallet != filter.Wallet || w.CoinID != filter.CoinID || w.RewardMethod != filter.RewardMethod || w.ID <= page.AfterID {
+			continue
+		}
+		if filter.Online != nil && w.IsConnect != *filter.Online {
+			continue
+		}
+		workers = append(workers, w)
+	}
+
+	sort.Slice(workers, func(i, j int) bool {
+		return workers[i].ID < workers[j].ID
+	})
+	if page.Limit > 0 && len(workers) > page.Limit {
+		workers = workers[:page.Limit]
+	}
+
+	return workers, nil
+}
+
+// byID поиск воркера по ID (вызывается под блокировкой)
+func (r *WorkerRepository) byID(id int64) (workerKey, entity.Worker, bool) {
+	for key, w := range r.workers {
+		if w.ID == id {
+			return key, w, true
+		}
+	}
+	return workerKey{}, entity.Worker{}, false
+}
+
+// remove удаление воркера вместе с историей адресов (вызывается под блокировкой)
+func (r *WorkerRepository) remove(key workerKey) {
+	id := r.workers[key].ID
+	delete(r.workers, key)
+	delete(r.history, id)
+	delete(r.updated, id)
+}
+
+// touchIP отметка подключения воркера с адреса (вызывается под блокировкой)
+func (r *WorkerRepository) touchIP(workerID int64, ip string) {
+	now := r.now()
+	for i, h := range r.history[workerID] {
+		if h.IP == ip {
+			r.history[workerID][i].LastSeen = now
+			return
+		}
+	}
+	r.history[workerID] = append(r.history[workerID], entity.WorkerIP{IP: ip, FirstSeen: now, LastSeen: now})
+}
@@ -0,0 +1,284 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+const pgerrUniqueViolation = "23505"
+
+// CoinRepository Postgresql реализация storage.CoinRepository
+type CoinRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewCoinRepository(pool *pgxpool.Pool) *CoinRepository {
+	return &CoinRepository{
+		pool: pool,
+	}
+}
+
+func (r *CoinRepository) GetCoinIDBySymbol(ctx context.Context, symbol string, includeInactive bool) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	// lower(symbol) - по индексу coins_lower_symbol_unique, lower(symbol2) - по частичному индексу
+	// coins_lower_symbol2_unique (условие symbol2 <> '' повторяет его предикат, иначе индекс не используется)
+	var id int64
+	err := r.pool.QueryRow(ctx, `SELECT id FROM coins 
+			WHERE (lower(symbol) = lower($1) OR (lower(symbol2) = lower($1) AND symbol2 <> '')) AND (is_active OR $2) 
+			ORDER BY lower(symbol) = lower($1) DESC 
+			LIMIT 1`,
+		symbol, includeInactive).Scan(&id)
+	if err != nil {
+		return 0, wrapNoRows(err)
+	}
+
+	return id, nil
+}
+
+func (r *CoinRepository) CoinExists(ctx context.Context, id int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	var exists bool
+	err := r.pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM coins WHERE id = $1)`, id).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// coinColumns колонки монеты в порядке scanCoin (numeric читается в entity.Decimal без потери точности)
+const coinColumns = `id, symbol, COALESCE(symbol2, ''), name, algo, image, min_withdraw, 
+		transactions_explorer, block_explorer, is_active, params::text, average_round_diff, average_solo_round_diff, 
+		current_effort, coins_in_block, average_pps_round_diff, average_effort, average_last_effort, 
+		seo_title, last_reward_processed_id, max_payment_threshold`
+
+func scanCoin(row pgx.Row) (entity.Coin, error) {
+	var c entity.Coin
+	var params []byte
+	err := row.Scan(&c.ID, &c.Symbol, &c.Symbol2, &c.Name, &c.Algo, &c.Image, numeric{&c.MinWithdraw},
+		&c.TransactionsExplorer, &c.BlockExplorer, &c.IsActive, &params, numeric{&c.AverageRoundDiff}, numeric{&c.AverageSoloRoundDiff},
+		numeric{&c.CurrentEffort}, numeric{&c.CoinsInBlock}, numeric{&c.AveragePPSRoundDiff}, numeric{&c.AverageEffort}, numeric{&c.AverageLastEffort},
+		&c.SeoTitle, &c.LastRewardProcessedID, nullNumeric{&c.MaxPaymentThreshold})
+	if err != nil {
+		return c, err
+	}
+
+	c.Params, err = unmarshalCoinParams(params)
+
+	return c, err
+}
+
+// unmarshalCoinParams чтение jsonb (без строгой проверки - записи могли появиться до типизации параметров)
+func unmarshalCoinParams(data []byte) (entity.CoinParams, error) {
+	var p entity.CoinParams
+	if err := json.Unmarshal(data, &p); err != nil {
+		return entity.CoinParams{}, fmt.Errorf("coin params: %w", err)
+	}
+
+	return p, nil
+}
+
+func (r *CoinRepository) ListCoins(ctx context.Context, activeOnly bool) ([]entity.Coin, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	rows, err := r.pool.Query(ctx, `SELECT `+coinColumns+` FROM coins WHERE is_active OR NOT $1 ORDER BY id`, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	coins := make([]entity.Coin, 0)
+	for rows.Next() {
+		c, err := scanCoin(rows)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, c)
+	}
+
+	return coins, rows.Err()
+}
+
+func (r *CoinRepository) GetCoin(ctx context.Context, id int64) (entity.Coin, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	c, err := scanCoin(r.pool.QueryRow(ctx, `SELECT `+coinColumns+` FROM coins WHERE id = $1`, id))
+	if err != nil {
+		return entity.Coin{}, wrapNoRows(err)
+	}
+
+	return c, nil
+}
+
+func (r *CoinRepository) CreateCoin(ctx context.Context, coin entity.Coin) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	if coin.Params.CurrencyRates == nil {
+		coin.Params.CurrencyRates = make(map[string]entity.Decimal) // объект, а не null (см. PatchCoinParams)
+	}
+	params, err := json.Marshal(coin.Params)
+	if err != nil {
+		return 0, err
+	}
+
+	var newID int64
+	err = r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		// проверка символа и вставка одним запросом (нет строк - символ занят)
+		err := tx.QueryRow(ctx, `INSERT INTO coins (symbol, symbol2, name, algo, image, min_withdraw, transactions_explorer, 
+					block_explorer, is_active, params, coins_in_block, seo_title, max_payment_threshold) 
+				SELECT $1, $2, $3, $4, $5, $6::numeric, $7, $8, $9, $10::jsonb, $11::numeric, $12, $13::numeric 
+				WHERE NOT EXISTS (SELECT 1 FROM coins WHERE `+symbolTakenCond("$1", "$2")+`) 
+				RETURNING id`,
+			coin.Symbol, coin.Symbol2, coin.Name, coin.Algo, coin.Image, numericArg(coin.MinWithdraw), coin.TransactionsExplorer,
+			coin.BlockExplorer, coin.IsActive, string(params), numericArg(coin.CoinsInBlock), coin.SeoTitle, nullNumericArg(coin.MaxPaymentThreshold)).Scan(&newID)
+		if errors.Is(err, pgx.ErrNoRows) || isUniqueViolation(err) {
+			return fmt.Errorf("%w: coin %s", storage.ErrAlreadyExists, coin.Symbol)
+		}
+		if err != nil {
+			return err
+		}
+
+		// новой монете доступны все методы начисления вознаграждения (как существующим в миграции 000006)
+		_, err = tx.Exec(ctx, `INSERT INTO coin_reward_methods (coin_id, reward_method) 
+				SELECT $1, code FROM reward_methods`, newID)
+
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return newID, nil
+}
+
+func (r *CoinRepository) UpdateCoin(ctx context.Context, coin entity.Coin) error {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	// UPDATE выполняется в CTE (только если символ не занят другой монетой),
+	// основной запрос возвращает причину, по которой запись могла не обновиться
+	var exists, symbolTaken bool
+	err := r.pool.QueryRow(ctx, `WITH u AS (
+				UPDATE coins SET symbol = $2, symbol2 = $3, name = $4, algo = $5, image = $6, min_withdraw = $7::numeric, 
+					transactions_explorer = $8, block_explorer = $9, coins_in_block = $10::numeric, seo_title = $11, 
+					max_payment_threshold = $12::numeric 
+				WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM coins WHERE `+symbolTakenCond("$2", "$3")+` AND id <> $1) 
+				RETURNING id
+			)
+			SELECT EXISTS (SELECT 1 FROM coins WHERE id = $1), EXISTS (SELECT 1 FROM coins WHERE `+symbolTakenCond("$2", "$3")+` AND id <> $1)`,
+		coin.ID, coin.Symbol, coin.Symbol2, coin.Name, coin.Algo, coin.Image, numericArg(coin.MinWithdraw),
+		coin.TransactionsExplorer, coin.BlockExplorer, numericArg(coin.CoinsInBlock), coin.SeoTitle, nullNumericArg(coin.MaxPaymentThreshold)).Scan(&exists, &symbolTaken)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("%w: coin %s", storage.ErrAlreadyExists, coin.Symbol)
+	}
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return wrapNoRows(pgx.ErrNoRows)
+	}
+	if symbolTaken {
+		return fmt.Errorf("%w: coin %s", storage.ErrAlreadyExists, coin.Symbol)
+	}
+
+	return nil
+}
+
+func (r *CoinRepository) SetCoinActive(ctx context.Context, id int64, active bool) error {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	tag, err := r.pool.Exec(ctx, `UPDATE coins SET is_active = $2 WHERE id = $1`, id, active)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return wrapNoRows(pgx.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (r *CoinRepository) GetCoinParams(ctx context.Context, id int64) (entity.CoinParams, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	var params []byte
+	err := r.pool.QueryRow(ctx, `SELECT params::text FROM coins WHERE id = $1`, id).Scan(&params)
+	if err != nil {
+		return entity.CoinParams{}, wrapNoRows(err)
+	}
+
+	return unmarshalCoinParams(params)
+}
+
+func (r *CoinRepository) PatchCoinParams(ctx context.Context, id int64, patch entity.CoinParamsPatch) (entity.CoinParams, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	fields, err := json.Marshal(patch.Fields())
+	if err != nil {
+		return entity.CoinParams{}, err
+	}
+	rates := []byte("{}")
+	if len(patch.CurrencyRates) > 0 {
+		if rates, err = json.Marshal(patch.CurrencyRates); err != nil {
+			return entity.CoinParams{}, err
+		}
+	}
+
+	// слияние на стороне БД - параллельные изменения разных параметров не затирают друг друга
+	var params []byte
+	err = r.pool.QueryRow(ctx, `UPDATE coins 
+			SET params = params || $2::jsonb || jsonb_build_object('currencyRates', 
+				CASE WHEN jsonb_typeof(params->'currencyRates') = 'object' THEN params->'currencyRates' ELSE '{}'::jsonb END || $3::jsonb) 
+			WHERE id = $1 
+			RETURNING params::text`,
+		id, string(fields), string(rates)).Scan(&params)
+	if err != nil {
+		return entity.CoinParams{}, wrapNoRows(err)
+	}
+
+	return unmarshalCoinParams(params)
+}
+
+// symbolTakenCond условие "символ или альтернативный символ монеты совпадает с symbolArg/symbol2Arg без учета регистра"
+// (пустой альтернативный символ ни с чем не совпадает)
+func symbolTakenCond(symbolArg string, symbol2Arg string) string {
+	values := "lower(" + symbolArg + "), lower(NULLIF(" + symbol2Arg + ", ''))"
+	return "(lower(symbol) IN (" + values + ") OR lower(symbol2) IN (" + values + "))"
+}
+
+// isUniqueViolation нарушение уникального индекса или проверки триггера coins_symbols_check
+// (параллельная вставка того же символа)
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrUniqueViolation
+}
+
+// wrapNoRows если записей нет - добавляем к ошибке storage.ErrNotFound (исходный текст ошибки сохраняется)
+func wrapNoRows(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %w", storage.ErrNotFound, err)
+	}
+
+	return err
+}
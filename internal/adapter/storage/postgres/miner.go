@@ -0,0 +1,292 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// MinerResolver Postgresql реализация storage.MinerResolver
+type MinerResolver struct {
+	pool *pgxpool.Pool
+}
+
+func NewMinerResolver(pool *pgxpool.Pool) *MinerResolver {
+	return &MinerResolver{
+		pool: pool,
+	}
+}
+
+type walletKey struct {
+	name         string
+	coinID       int64
+	rewardMethod entity.RewardMethod
+}
+
+type workerKey struct {
+	workerfull   string
+	coinID       int64
+	rewardMethod entity.RewardMethod
+}
+
+func (r *MinerResolver) ResolveMiners(ctx context.Context, miners []entity.MinerIdentity) ([]entity.MinerIDs, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	result := make([]entity.MinerIDs, len(miners))
+	if len(miners) == 0 {
+		return result, nil
+	}
+
+	err := r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		coins, err := r.resolveCoins(ctx, tx, miners)
+		if err != nil {
+			return err
+		}
+
+		wallets, err := r.upsertWallets(ctx, tx, miners, coins)
+		if err != nil {
+			return err
+		}
+
+		workers, err := r.upsertWorkers(ctx, tx, miners, coins)
+		if err != nil {
+			return err
+		}
+
+		for i, m := range miners {
+			coinID, ok := coins[m.Coin]
+			if !ok {
+				continue
+			}
+			result[i] = entity.MinerIDs{
+				CoinID:   coinID,
+				WalletID: wallets[walletKey{name: m.Wallet, coinID: coinID, rewardMethod: m.RewardMethod}],
+				WorkerID: workers[workerKey{workerfull: m.Workerfull, coinID: coinID, rewardMethod: m.RewardMethod}],
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// resolveCoins символ монеты (как в запросе) => ID, по тем же правилам, что и CoinRepository.GetCoinIDBySymbol
+// для активных монет (неизвестных монет в результате нет)
+func (r *MinerResolver) resolveCoins(ctx context.Context, tx pgx.Tx, miners []entity.MinerIdentity) (map[string]int64, error) {
+	uniq := make(map[string]struct{})
+	symbols := make([]string, 0)
+	for _, m := range miners {
+		lower := strings.ToLower(m.Coin)
+		if _, ok := uniq[lower]; !ok {
+			uniq[lower] = struct{}{}
+			symbols = append(symbols, lower)
+		}
+	}
+
+	rows, err := tx.Query(ctx, `SELECT id, lower(symbol), lower(COALESCE(symbol2, '')) FROM coins 
+			WHERE (lower(symbol) = ANY($1) OR lower(symbol2) = ANY($1)) AND is_active`, symbols)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bySymbol := make(map[string]int64, len(symbols))
+	byAlias := make(map[string]int64)
+	for rows.Next() {
+		var id int64
+		var symbol, symbol2 string
+		if err := rows.Scan(&id, &symbol, &symbol2); err != nil {
+			return nil, err
+		}
+		bySymbol[symbol] = id
+		if symbol2 != "" {
+			byAlias[symbol2] = id
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// совпадение с основным символом приоритетнее альтернативного
+	coins := make(map[string]int64, len(miners))
+	for _, m := range miners {
+		lower := strings.ToLower(m.Coin)
+		if id, ok := bySymbol[lower]; ok {
+			coins[m.Coin] = id
+		} else if id, ok := byAlias[lower]; ok {
+			coins[m.Coin] = id
+		}
+	}
+
+	return coins, nil
+}
+
+// upsertWallets создание недостающих кошельков одним запросом, возвращает ID всех кошельков пакета
+func (r *MinerResolver) upsertWallets(ctx context.Context, tx pgx.Tx, miners []entity.MinerIdentity, coins map[string]int64) (map[walletKey]int64, error) {
+	// уникальные ключи (ON CONFLICT DO UPDATE не может обновить одну строку дважды за запрос)
+	uniq := make(map[walletKey]struct{})
+	keys := make([]walletKey, 0)
+	for _, m := range miners {
+		coinID, ok := coins[m.Coin]
+		if !ok {
+			continue
+		}
+		key := walletKey{name: m.Wallet, coinID: coinID, rewardMethod: m.RewardMethod}
+		if _, ok := uniq[key]; !ok {
+			uniq[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+
+	// одинаковый порядок вставки во всех транзакциях - защита от взаимных блокировок
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].coinID != keys[j].coinID {
+			return keys[i].coinID < keys[j].coinID
+		}
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		return keys[i].rewardMethod < keys[j].rewardMethod
+	})
+
+	coinIDs := make([]int64, len(keys))
+	names := make([]string, len(keys))
+	rewardMethods := make([]string, len(keys))
+	solos := make([]bool, len(keys))
+	for i, k := range keys {
+		coinIDs[i], names[i], rewardMethods[i], solos[i] = k.coinID, k.name, string(k.rewardMethod), k.rewardMethod.IsSolo()
+	}
+
+	rows, err := tx.Query(ctx, `INSERT INTO wallets (coin_id, name, reward_method, is_solo) 
+			SELECT * FROM unnest($1::bigint[], $2::varchar[], $3::varchar[], $4::boolean[]) 
+			ON CONFLICT (name, coin_id, reward_method) WHERE deleted_at IS NULL DO UPDATE SET name = EXCLUDED.name 
+			RETURNING id, coin_id, name, reward_method`,
+		coinIDs, names, rewardMethods, solos)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	wallets := make(map[walletKey]int64, len(keys))
+	for rows.Next() {
+		var id int64
+		var key walletKey
+		if err := rows.Scan(&id, &key.coinID, &key.name, &key.rewardMethod); err != nil {
+			return nil, err
+		}
+		wallets[key] = id
+	}
+
+	return wallets, rows.Err()
+}
+
+// upsertWorkers создание недостающих воркеров одним запросом, возвращает ID всех воркеров пакета
+func (r *MinerResolver) upsertWorkers(ctx context.Context, tx pgx.Tx, miners []entity.MinerIdentity, coins map[string]int64) (map[workerKey]int64, error) {
+	uniq := make(map[workerKey]struct{})
+	keys := make([]workerKey, 0)
+	data := make(map[workerKey]entity.MinerIdentity)
+	for _, m := range miners {
+		coinID, ok := coins[m.Coin]
+		if !ok {
+			continue
+		}
+		key := workerKey{workerfull: m.Workerfull, coinID: coinID, rewardMethod: m.RewardMethod}
+		if _, ok := uniq[key]; !ok {
+			uniq[key] = struct{}{}
+			keys = append(keys, key)
+		}
+		data[key] = m // при повторах в пакете берется последний (самый свежий) IP
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].coinID != keys[j].coinID {
+			return keys[i].coinID < keys[j].coinID
+		}
+		if keys[i].workerfull != keys[j].workerfull {
+			return keys[i].workerfull < keys[j].workerfull
+		}
+		return keys[i].rewardMethod < keys[j].rewardMethod
+	})
+
+	n := len(keys)
+	coinIDs := make([]int64, n)
+	workerfulls := make([]string, n)
+	walletNames := make([]string, n)
+	workerNames := make([]string, n)
+	serverIDs := make([]string, n)
+	ips := make([]string, n)
+	rewardMethods := make([]string, n)
+	solos := make([]bool, n)
+	for i, k := range keys {
+		m := data[k]
+		coinIDs[i], workerfulls[i], rewardMethods[i], solos[i] = k.coinID, k.workerfull, string(k.rewardMethod), k.rewardMethod.IsSolo()
+		walletNames[i], workerNames[i], serverIDs[i], ips[i] = m.Wallet, m.Worker, m.ServerID, m.IP
+	}
+
+	now := time.Now().Format("2006-01-02 15:04:05.000")
+
+	rows, err := tx.Query(ctx, `INSERT INTO workers (coin_id, workerfull, wallet, worker, server_id, ip, created_at, updated_at, is_solo, reward_method) 
+			SELECT t.coin_id, t.workerfull, t.wallet, t.worker, t.server_id, NULLIF(t.ip, ''), $9::timestamp, $9::timestamp, t.is_solo, t.reward_method 
+			FROM unnest($1::bigint[], $2::varchar[], $3::varchar[], $4::varchar[], $5::varchar[], $6::varchar[], $7::varchar[], $8::boolean[]) 
+				AS t(coin_id, workerfull, wallet, worker, server_id, ip, reward_method, is_solo) 
+			ON CONFLICT (workerfull, coin_id, reward_method) WHERE deleted_at IS NULL DO UPDATE SET ip = COALESCE(EXCLUDED.ip, workers.ip), 
+				updated_at = EXCLUDED.updated_at, server_id = EXCLUDED.server_id 
+			RETURNING id, coin_id, workerfull, reward_method`,
+		coinIDs, workerfulls, walletNames, workerNames, serverIDs, ips, rewardMethods, solos, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	workers := make(map[workerKey]int64, n)
+	for rows.Next() {
+		var id int64
+		var key workerKey
+		if err := rows.Scan(&id, &key.coinID, &key.workerfull, &key.rewardMethod); err != nil {
+			return nil, err
+		}
+		workers[key] = id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return workers, r.upsertIPHistory(ctx, tx, keys, data, workers, now)
+}
+
+// upsertIPHistory отметка адресов подключения воркеров пакета
+func (r *MinerResolver) upsertIPHistory(ctx context.Context, tx pgx.Tx, keys []workerKey, data map[workerKey]entity.MinerIdentity, workers map[workerKey]int64, now string) error {
+	workerIDs := make([]int64, 0, len(keys))
+	ips := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if data[k].IP == "" {
+			continue
+		}
+		workerIDs = append(workerIDs, workers[k])
+		ips = append(ips, data[k].IP)
+	}
+	if len(workerIDs) == 0 {
+		return nil
+	}
+
+	_, err := tx.Exec(ctx, `INSERT INTO ip_history (worker_id, ip, first_seen, last_seen) 
+			SELECT t.worker_id, t.ip, $3::timestamp, $3::timestamp 
+			FROM unnest($1::bigint[], $2::varchar[]) AS t(worker_id, ip) 
+			ORDER BY t.worker_id 
+			ON CONFLICT (worker_id, ip) DO UPDATE SET last_seen = EXCLUDED.last_seen`,
+		workerIDs, ips, now)
+
+	return err
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgtype"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// numeric чтение колонки numeric в entity.Decimal (двоичный и текстовый формат pgx)
+// значение переносится целым коэффициентом и масштабом - без промежуточного float64, масштаб колонки сохраняется
+type numeric struct {
+	dst *entity.Decimal
+}
+
+func (n numeric) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var v pgtype.Numeric
+	if err := v.DecodeBinary(ci, src); err != nil {
+		return err
+	}
+	return n.set(v)
+}
+
+func (n numeric) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var v pgtype.Numeric
+	if err := v.DecodeText(ci, src); err != nil {
+		return err
+	}
+	return n.set(v)
+}
+
+func (n numeric) set(v pgtype.Numeric) error {
+	if v.Status != pgtype.Present {
+		return fmt.Errorf("numeric: cannot scan NULL into entity.Decimal")
+	}
+	d, err := decimalFromNumeric(v)
+	if err != nil {
+		return err
+	}
+	*n.dst = d
+
+	return nil
+}
+
+// nullNumeric то же для колонок, допускающих NULL (NULL - nil)
+type nullNumeric struct {
+	dst **entity.Decimal
+}
+
+func (n nullNumeric) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var v pgtype.Numeric
+	if err := v.DecodeBinary(ci, src); err != nil {
+		return err
+	}
+	return n.set(v)
+}
+
+func (n nullNumeric) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var v pgtype.Numeric
+	if err := v.DecodeText(ci, src); err != nil {
+		return err
+	}
+	return n.set(v)
+}
+
+func (n nullNumeric) set(v pgtype.Numeric) error {
+	if v.Status != pgtype.Present {
+		*n.dst = nil
+		return nil
+	}
+	d, err := decimalFromNumeric(v)
+	if err != nil {
+		return err
+	}
+	*n.dst = &d
+
+	return nil
+}
+
+func decimalFromNumeric(v pgtype.Numeric) (entity.Decimal, error) {
+	if v.NaN || v.InfinityModifier != pgtype.None {
+		return entity.Decimal{}, fmt.Errorf("numeric: not a finite number")
+	}
+	// без знаков после запятой pgtype отдает положительную экспоненту (1000 = 1 * 10^3) - NewDecimal ее раскрывает
+	return entity.NewDecimal(v.Int, -v.Exp), nil
+}
+
+// numericArg параметр запроса для колонки numeric (масштаб значения передается в dscale)
+func numericArg(d entity.Decimal) pgtype.Numeric {
+	return pgtype.Numeric{Int: d.Coefficient(), Exp: -d.Scale(), Status: pgtype.Present}
+}
+
+// nullNumericArg nil - NULL
+func nullNumericArg(d *entity.Decimal) pgtype.Numeric {
+	if d == nil {
+		return pgtype.Numeric{Status: pgtype.Null}
+	}
+	return numericArg(*d)
+}
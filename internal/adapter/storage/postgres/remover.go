@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// MinerRemover Postgresql реализация storage.MinerRemover
+// записи не удаляются физически - на них ссылаются журналы (ip_history, payment_threshold_history, settings_changes)
+type MinerRemover struct {
+	pool *pgxpool.Pool
+}
+
+func NewMinerRemover(pool *pgxpool.Pool) *MinerRemover {
+	return &MinerRemover{
+		pool: pool,
+	}
+}
+
+// removedWorkerColumns колонки воркера в порядке scanRemovedWorker
+const removedWorkerColumns = `id, coin_id, workerfull, wallet, worker, server_id, COALESCE(ip, ''), reward_method`
+
+func scanRemovedWorker(row pgx.Row) (entity.Worker, error) {
+	var w entity.Worker
+	err := row.Scan(&w.ID, &w.CoinID, &w.Workerfull, &w.Wallet, &w.Worker, &w.ServerID, &w.IP, &w.RewardMethod)
+
+	return w, err
+}
+
+func (r *MinerRemover) DeleteWallet(ctx context.Context, walletID int64) (entity.Wallet, []entity.Worker, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	now := time.Now().Format("2006-01-02 15:04:05.000")
+
+	var wallet entity.Wallet
+	workers := make([]entity.Worker, 0)
+	err := r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, `UPDATE wallets SET deleted_at = $2 
+				WHERE id = $1 AND deleted_at IS NULL 
+				RETURNING id, coin_id, name, reward_method`,
+			walletID, now).Scan(&wallet.ID, &wallet.CoinID, &wallet.Name, &wallet.RewardMethod)
+		if err != nil {
+			return wrapNoRows(err)
+		}
+
+		rows, err := tx.Query(ctx, `UPDATE workers SET deleted_at = $4, is_connect = false 
+				WHERE wallet = $1 AND coin_id = $2 AND reward_method = $3 AND deleted_at IS NULL 
+				RETURNING `+removedWorkerColumns,
+			wallet.Name, wallet.CoinID, wallet.RewardMethod, now)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			w, err := scanRemovedWorker(rows)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+			workers = append(workers, w)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		// токены подтверждения удаленного кошелька больше не действуют
+		_, err = tx.Exec(ctx, `UPDATE settings_changes SET cancelled_at = $2 
+				WHERE wallet_id = $1 AND confirmed_at IS NULL AND cancelled_at IS NULL`,
+			walletID, now)
+
+		return err
+	})
+	if err != nil {
+		return entity.Wallet{}, nil, err
+	}
+
+	return wallet, workers, nil
+}
+
+func (r *MinerRemover) DeleteWorker(ctx context.Context, workerID int64) (entity.Worker, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	now := time.Now().Format("2006-01-02 15:04:05.000")
+
+	var worker entity.Worker
+	err := r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		worker, err = scanRemovedWorker(tx.QueryRow(ctx, `UPDATE workers SET deleted_at = $2, is_connect = false 
+				WHERE id = $1 AND deleted_at IS NULL 
+				RETURNING `+removedWorkerColumns,
+			workerID, now))
+		if err != nil {
+			return wrapNoRows(err)
+		}
+
+		return rollupWalletHashrate(ctx, tx, worker)
+	})
+	if err != nil {
+		return entity.Worker{}, err
+	}
+
+	return worker, nil
+}
+
+func (r *MinerRemover) MergeWorkers(ctx context.Context, sourceID int64, targetID int64) (entity.Worker, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	now := time.Now().Format("2006-01-02 15:04:05.000")
+
+	var source entity.Worker
+	err := r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		// блокировка обоих воркеров в порядке ID - защита от взаимных блокировок при встречных объединениях
+		rows, err := tx.Query(ctx, `SELECT `+removedWorkerColumns+` FROM workers 
+				WHERE id = ANY($1) AND deleted_at IS NULL 
+				ORDER BY id 
+				FOR UPDATE`,
+			[]int64{sourceID, targetID})
+		if err != nil {
+			return err
+		}
+		locked := make(map[int64]entity.Worker, 2)
+		for rows.Next() {
+			w, err := scanRemovedWorker(rows)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+			locked[w.ID] = w
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		var target entity.Worker
+		var ok bool
+		if source, ok = locked[sourceID]; !ok {
+			return wrapNoRows(pgx.ErrNoRows)
+		}
+		if target, ok = locked[targetID]; !ok {
+			return wrapNoRows(pgx.ErrNoRows)
+		}
+		if source.Wallet != target.Wallet || source.CoinID != target.CoinID || source.RewardMethod != target.RewardMethod {
+			return storage.ErrWorkersMismatch
+		}
+
+		// история адресов переносится до удаления строк source (ограничение ip_history_worker_id_ip_unique)
+		_, err = tx.Exec(ctx, `INSERT INTO ip_history (worker_id, ip, first_seen, last_seen) 
+				SELECT $2, ip, first_seen, last_seen FROM ip_history WHERE worker_id = $1 
+				ON CONFLICT (worker_id, ip) DO UPDATE SET 
+					first_seen = LEAST(ip_history.first_seen, EXCLUDED.first_seen), 
+					last_seen = GREATEST(ip_history.last_seen, EXCLUDED.last_seen)`,
+			sourceID, targetID)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM ip_history WHERE worker_id = $1`, sourceID); err != nil {
+			return err
+		}
+
+		// хешрейт складывается - сумма по кошельку не меняется до следующего UpdateWorkerStats
+		_, err = tx.Exec(ctx, `UPDATE workers t SET 
+				current_hashrate = t.current_hashrate + s.current_hashrate, 
+				average_hashrate = t.average_hashrate + s.average_hashrate, 
+				is_connect = t.is_connect OR s.is_connect, 
+				last_share_date = GREATEST(t.last_share_date, s.last_share_date), 
+				created_at = LEAST(t.created_at, s.created_at) 
+			FROM workers s 
+			WHERE t.id = $2 AND s.id = $1`,
+			sourceID, targetID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE workers SET deleted_at = $3, merged_into = $2, is_connect = false, current_hashrate = 0, average_hashrate = 0 
+				WHERE id = $1`,
+			sourceID, targetID, now)
+		if err != nil {
+			return err
+		}
+
+		// ранее объединенные с source воркеры ссылаются сразу на target
+		_, err = tx.Exec(ctx, `UPDATE workers SET merged_into = $2 WHERE merged_into = $1`, sourceID, targetID)
+
+		return err
+	})
+	if err != nil {
+		return entity.Worker{}, err
+	}
+
+	return source, nil
+}
+
+// rollupWalletHashrate пересчет хешрейта кошелька воркера по неудаленным воркерам
+func rollupWalletHashrate(ctx context.Context, tx pgx.Tx, worker entity.Worker) error {
+	_, err := tx.Exec(ctx, `UPDATE wallets wl SET 
+			current_hashrate = t.current_hashrate, 
+			average_hashrate = t.average_hashrate 
+		FROM (
+			SELECT COALESCE(SUM(current_hashrate), 0)::bigint AS current_hashrate, COALESCE(SUM(average_hashrate), 0)::bigint AS average_hashrate 
+			FROM workers 
+			WHERE wallet = $1 AND coin_id = $2 AND reward_method = $3 AND deleted_at IS NULL
+		) t 
+		WHERE wl.name = $1 AND wl.coin_id = $2 AND wl.reward_method = $3 AND wl.deleted_at IS NULL`,
+		worker.Wallet, worker.CoinID, worker.RewardMethod)
+
+	return err
+}
@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// RewardMethodRepository Postgresql реализация storage.RewardMethodRepository
+type RewardMethodRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRewardMethodRepository(pool *pgxpool.Pool) *RewardMethodRepository {
+	return &RewardMethodRepository{
+		pool: pool,
+	}
+}
+
+func (r *RewardMethodRepository) ListRewardMethodsByCoin(ctx context.Context, coinID int64) ([]entity.RewardMethodInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	rows, err := r.pool.Query(ctx, `SELECT m.code, m.name 
+			FROM coin_reward_methods cm JOIN reward_methods m ON m.code = cm.reward_method 
+			WHERE cm.coin_id = $1 ORDER BY m.code`, coinID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	methods := make([]entity.RewardMethodInfo, 0)
+	for rows.Next() {
+		var m entity.RewardMethodInfo
+		if err := rows.Scan(&m.Code, &m.Name); err != nil {
+			return nil, err
+		}
+		methods = append(methods, m)
+	}
+
+	return methods, rows.Err()
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// SettingsChangeRepository Postgresql реализация storage.SettingsChangeRepository
+type SettingsChangeRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewSettingsChangeRepository(pool *pgxpool.Pool) *SettingsChangeRepository {
+	return &SettingsChangeRepository{
+		pool: pool,
+	}
+}
+
+func (r *SettingsChangeRepository) CreateSettingsChange(ctx context.Context, change entity.SettingsChange) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	now := time.Now().Format("2006-01-02 15:04:05.000")
+
+	var newID int64
+	err := r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		// блокировка кошелька - конкурентные запросы одной настройки выполняются по очереди
+		var walletID int64
+		err := tx.QueryRow(ctx, `SELECT id FROM wallets WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, change.WalletID).Scan(&walletID)
+		if err != nil {
+			return wrapNoRows(err)
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE settings_changes SET cancelled_at = $3 
+				WHERE wallet_id = $1 AND kind = $2 AND confirmed_at IS NULL AND cancelled_at IS NULL`,
+			change.WalletID, change.Kind, now)
+		if err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, `INSERT INTO settings_changes (wallet_id, kind, value, token_hash, service, actor, created_at, expires_at) 
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+				RETURNING id`,
+			change.WalletID, change.Kind, change.Value, change.TokenHash, change.Service, change.Actor, now,
+			change.ExpiresAt.Format("2006-01-02 15:04:05.000")).Scan(&newID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return newID, nil
+}
+
+func (r *SettingsChangeRepository) ConfirmSettingsChange(ctx context.Context, tokenHash string, service string) (entity.SettingsChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	now := time.Now().Format("2006-01-02 15:04:05.000")
+
+	var change entity.SettingsChange
+	err := r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		// отметка о подтверждении и блокировка записи одним запросом - повторно токен не сработает
+		err := tx.QueryRow(ctx, `UPDATE settings_changes SET confirmed_at = $2 
+				WHERE token_hash = $1 AND confirmed_at IS NULL AND cancelled_at IS NULL AND expires_at > $2 
+				RETURNING id, wallet_id, kind, value, service, actor, created_at, expires_at, confirmed_at`,
+			tokenHash, now).Scan(&change.ID, &change.WalletID, &change.Kind, &change.Value, &change.Service, &change.Actor,
+			&change.CreatedAt, &change.ExpiresAt, &change.ConfirmedAt)
+		if err != nil {
+			return wrapNoRows(err)
+		}
+
+		switch change.Kind {
+		case entity.SettingsKindPaymentThreshold:
+			var coin entity.Coin
+			err := tx.QueryRow(ctx, `SELECT c.min_withdraw, c.max_payment_threshold 
+					FROM wallets w JOIN coins c ON c.id = w.coin_id WHERE w.id = $1 AND w.deleted_at IS NULL`,
+				change.WalletID).Scan(numeric{&coin.MinWithdraw}, nullNumeric{&coin.MaxPaymentThreshold})
+			if err != nil {
+				return wrapNoRows(err)
+			}
+			threshold, err := entity.ParseDecimal(change.Value)
+			if err != nil {
+				return fmt.Errorf("%w: %v", entity.ErrPaymentThresholdOutOfRange, err)
+			}
+			if err := entity.ValidatePaymentThreshold(threshold, coin); err != nil {
+				return err
+			}
+
+			applied, err := setPaymentThreshold(ctx, tx, entity.PaymentThresholdChange{
+				WalletID: change.WalletID,
+				NewValue: threshold,
+				Service:  service,
+				Actor:    change.Actor,
+			})
+			if err != nil {
+				return err
+			}
+			change.Value = applied.NewValue.String()
+
+		default:
+			return fmt.Errorf("unknown settings kind %q", change.Kind)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.SettingsChange{}, err
+	}
+
+	return change, nil
+}
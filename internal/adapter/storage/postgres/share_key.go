@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+)
+
+// ShareKeyRepository Postgresql реализация storage.ShareKeyRepository
+type ShareKeyRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewShareKeyRepository(pool *pgxpool.Pool) *ShareKeyRepository {
+	return &ShareKeyRepository{
+		pool: pool,
+	}
+}
+
+// ClaimShareKeys одним запросом: параллельная вставка того же ключа ждет завершения первой транзакции
+// и не проходит условие обновления; ключи вставляются по порядку (пересекающиеся пакеты не взаимоблокируются)
+func (r *ShareKeyRepository) ClaimShareKeys(ctx context.Context, keys []string, seenAt time.Time, since time.Time) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	claimed := make([]string, 0, len(keys))
+	if len(keys) == 0 {
+		return claimed, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `INSERT INTO share_keys (key, seen_at) 
+			SELECT DISTINCT k, $2::timestamp FROM unnest($1::text[]) AS k ORDER BY k 
+			ON CONFLICT (key) DO UPDATE SET seen_at = EXCLUDED.seen_at WHERE share_keys.seen_at < $3::timestamp 
+			RETURNING key`,
+		keys, seenAt.Format("2006-01-02 15:04:05.000"), since.Format("2006-01-02 15:04:05.000"))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		claimed = append(claimed, key)
+	}
+
+	return claimed, rows.Err()
+}
+
+func (r *ShareKeyRepository) ReleaseShareKeys(ctx context.Context, keys []string, seenAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	_, err := r.pool.Exec(ctx, `DELETE FROM share_keys WHERE key = ANY($1) AND seen_at = $2::timestamp`,
+		keys, seenAt.Format("2006-01-02 15:04:05.000"))
+
+	return err
+}
+
+func (r *ShareKeyRepository) DeleteShareKeys(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM share_keys WHERE seen_at < $1`, before.Format("2006-01-02 15:04:05.000"))
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// WorkerStatsUpdater Postgresql реализация storage.WorkerStatsUpdater
+type WorkerStatsUpdater struct {
+	pool *pgxpool.Pool
+}
+
+func NewWorkerStatsUpdater(pool *pgxpool.Pool) *WorkerStatsUpdater {
+	return &WorkerStatsUpdater{
+		pool: pool,
+	}
+}
+
+// UpdateWorkerStats пакет копируется (COPY) во временную таблицу, затем воркеры и их кошельки обновляются
+// запросами UPDATE ... FROM (вместо отдельного UPDATE на каждого воркера).
+// UPDATE ... FROM блокирует строки в порядке соединения, поэтому перед ним воркеры и кошельки блокируются
+// по возрастанию id - параллельные пакеты с пересекающимися воркерами ждут друг друга, а не взаимоблокируются
+func (r *WorkerStatsUpdater) UpdateWorkerStats(ctx context.Context, stats []entity.WorkerStats) (int, []entity.WorkerStatusEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	if len(stats) == 0 {
+		return 0, nil, nil
+	}
+
+	at := time.Now()
+	now := at.Format("2006-01-02 15:04:05.000")
+
+	// UPDATE ... FROM не может обновить одну строку дважды - для повторяющихся воркеров берем последнее состояние
+	last := make(map[int64]int, len(stats))
+	for i, s := range stats {
+		last[s.WorkerID] = i
+	}
+	ids := make([]int64, 0, len(last))
+	for id := range last {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	rows := make([][]interface{}, 0, len(ids))
+	for _, id := range ids {
+		s := stats[last[id]]
+		var lastShareDate *string
+		if !s.LastShareDate.IsZero() {
+			v := s.LastShareDate.Format("2006-01-02 15:04:05")
+			lastShareDate = &v
+		}
+		rows = append(rows, []interface{}{s.WorkerID, s.IsConnect, s.CurrentHashrate, s.AverageHashrate, lastShareDate, nullNumericArg(s.CurrentDiff), s.ReportedHashrate})
+	}
+
+	var updated int
+	events := make([]entity.WorkerStatusEvent, 0)
+	err := r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `CREATE TEMP TABLE worker_stats_batch (
+				worker_id bigint NOT NULL, 
+				is_connect boolean NOT NULL, 
+				current_hashrate bigint NOT NULL, 
+				average_hashrate bigint NOT NULL, 
+				last_share_date text, 
+				current_diff numeric, 
+				reported_hashrate bigint
+			) ON COMMIT DROP`)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"worker_stats_batch"},
+			[]string{"worker_id", "is_connect", "current_hashrate", "average_hashrate", "last_share_date", "current_diff", "reported_hashrate"},
+			pgx.CopyFromRows(rows))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `SELECT id FROM workers 
+			WHERE id IN (SELECT worker_id FROM worker_stats_batch) 
+			ORDER BY id 
+			FOR UPDATE`)
+		if err != nil {
+			return err
+		}
+
+		// o - состояние воркера до изменения (для событий подключения/отключения)
+		res, err := tx.Query(ctx, `UPDATE workers w SET 
+				is_connect = s.is_connect, 
+				current_hashrate = s.current_hashrate, 
+				average_hashrate = s.average_hashrate, 
+				last_share_date = COALESCE(s.last_share_date::timestamp, w.last_share_date), 
+				current_diff = COALESCE(s.current_diff, w.current_diff), 
+				reported_hashrate = COALESCE(s.reported_hashrate, w.reported_hashrate), 
+				reported_hashrate_date = CASE WHEN s.reported_hashrate IS NULL THEN w.reported_hashrate_date ELSE $1::timestamp END, 
+				updated_at = $1::timestamp 
+			FROM worker_stats_batch s JOIN workers o ON o.id = s.worker_id 
+			WHERE w.id = s.worker_id AND w.deleted_at IS NULL 
+			RETURNING w.id, w.coin_id, w.wallet, w.workerfull, w.reward_method, w.is_connect, o.is_connect, w.last_share_date`, now)
+		if err != nil {
+			return err
+		}
+		for res.Next() {
+			var e entity.WorkerStatusEvent
+			var wasConnect bool
+			var lastShareDate *time.Time
+			if err := res.Scan(&e.WorkerID, &e.CoinID, &e.Wallet, &e.Workerfull, &e.RewardMethod, &e.Online, &wasConnect, &lastShareDate); err != nil {
+				res.Close()
+				return err
+			}
+			updated++
+			if e.Online == wasConnect {
+				continue
+			}
+			if lastShareDate != nil {
+				e.LastShareDate = *lastShareDate
+			}
+			e.Time = at
+			events = append(events, e)
+		}
+		res.Close()
+		if err := res.Err(); err != nil {
+			return err
+		}
+
+		// сводка по кошелькам затронутых воркеров
+		return recalcWalletHashrates(ctx, tx, `SELECT b.wallet, b.coin_id, b.reward_method 
+			FROM workers b JOIN worker_stats_batch s ON s.worker_id = b.id`)
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return updated, events, nil
+}
+
+// MarkWorkersOffline воркер без шар считается молчащим с момента последнего обновления (или создания),
+// у отключенного воркера обнуляется текущий хешрейт, хешрейт его кошелька пересчитывается
+func (r *WorkerStatsUpdater) MarkWorkersOffline(ctx context.Context, coinID int64, silentSince time.Time) ([]entity.WorkerStatusEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	at := time.Now()
+
+	var events []entity.WorkerStatusEvent
+	err := r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		// воркеры блокируются по возрастанию id (как в UpdateWorkerStats)
+		rows, err := tx.Query(ctx, `UPDATE workers SET is_connect = false, current_hashrate = 0, updated_at = $3::timestamp 
+				WHERE id IN (
+					SELECT id FROM workers 
+					WHERE coin_id = $1 AND is_connect AND deleted_at IS NULL AND COALESCE(last_share_date, updated_at, created_at) < $2::timestamp 
+					ORDER BY id 
+					FOR UPDATE
+				) 
+				RETURNING id, coin_id, wallet, workerfull, reward_method, last_share_date`,
+			coinID, silentSince.Format("2006-01-02 15:04:05.000"), at.Format("2006-01-02 15:04:05.000"))
+		if err != nil {
+			return err
+		}
+
+		events = make([]entity.WorkerStatusEvent, 0)
+		var wallets, rewardMethods []string
+		for rows.Next() {
+			e := entity.WorkerStatusEvent{Time: at}
+			var lastShareDate *time.Time
+			if err := rows.Scan(&e.WorkerID, &e.CoinID, &e.Wallet, &e.Workerfull, &e.RewardMethod, &lastShareDate); err != nil {
+				rows.Close()
+				return err
+			}
+			if lastShareDate != nil {
+				e.LastShareDate = *lastShareDate
+			}
+			events = append(events, e)
+			wallets = append(wallets, e.Wallet)
+			rewardMethods = append(rewardMethods, string(e.RewardMethod))
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		return recalcWalletHashrates(ctx, tx, `SELECT t.wallet, $1::bigint, t.reward_method 
+			FROM unnest($2::varchar[], $3::varchar[]) AS t(wallet, reward_method)`, coinID, wallets, rewardMethods)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// recalcWalletHashrates пересчет хешрейта кошельков (сумма по всем неудаленным воркерам кошелька),
+// walletsQuery - запрос (wallet, coin_id, reward_method) затронутых кошельков.
+// Кошельки блокируются по возрастанию id до UPDATE ... FROM
+func recalcWalletHashrates(ctx context.Context, tx pgx.Tx, walletsQuery string, args ...interface{}) error {
+	_, err := tx.Exec(ctx, `SELECT wl.id FROM wallets wl 
+			WHERE wl.deleted_at IS NULL AND (wl.name, wl.coin_id, wl.reward_method) IN (`+walletsQuery+`) 
+			ORDER BY wl.id 
+			FOR UPDATE`, args...)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE wallets wl SET 
+			current_hashrate = t.current_hashrate, 
+			average_hashrate = t.average_hashrate 
+		FROM (
+			SELECT w.wallet, w.coin_id, w.reward_method, 
+				SUM(w.current_hashrate)::bigint AS current_hashrate, SUM(w.average_hashrate)::bigint AS average_hashrate 
+			FROM workers w 
+			WHERE w.deleted_at IS NULL AND (w.wallet, w.coin_id, w.reward_method) IN (`+walletsQuery+`) 
+			GROUP BY w.wallet, w.coin_id, w.reward_method
+		) t 
+		WHERE wl.name = t.wallet AND wl.coin_id = t.coin_id AND wl.reward_method = t.reward_method AND wl.deleted_at IS NULL`, args...)
+
+	return err
+}
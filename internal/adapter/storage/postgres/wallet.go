@@ -0,0 +1,263 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// WalletRepository Postgresql реализация storage.WalletRepository
+type WalletRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewWalletRepository(pool *pgxpool.Pool) *WalletRepository {
+	return &WalletRepository{
+		pool: pool,
+	}
+}
+
+func (r *WalletRepository) GetWalletByName(ctx context.Context, name string, coinID int64, rewardMethod entity.RewardMethod) (entity.Wallet, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	var w entity.Wallet
+	err := r.pool.QueryRow(ctx, `SELECT id, coin_id, name, reward_method 
+			FROM wallets WHERE name = $1 AND coin_id = $2 AND reward_method = $3 AND deleted_at IS NULL`,
+		name, coinID, rewardMethod).Scan(&w.ID, &w.CoinID, &w.Name, &w.RewardMethod)
+	if err != nil {
+		return entity.Wallet{}, wrapNoRows(err)
+	}
+
+	return w, nil
+}
+
+func (r *WalletRepository) CreateWallet(ctx context.Context, wallet entity.Wallet) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	// ON CONFLICT DO UPDATE (а не DO NOTHING) - чтобы RETURNING вернул id уже существующей записи
+	// is_solo заполняется по методу начисления до удаления колонки
+	var newID int64
+	err := r.pool.QueryRow(ctx, `INSERT INTO wallets (coin_id, name, is_solo, reward_method) 
+			VALUES ($1, $2, $3, $4) 
+			ON CONFLICT (name, coin_id, reward_method) WHERE deleted_at IS NULL DO UPDATE SET name = EXCLUDED.name 
+			RETURNING id`,
+		wallet.CoinID, wallet.Name, wallet.RewardMethod.IsSolo(), wallet.RewardMethod).Scan(&newID)
+	if err != nil {
+		return 0, err
+	}
+
+	return newID, nil
+}
+
+// walletSortColumns колонки сортировки (у каждой есть индекс с id, см. миграцию 000011)
+var walletSortColumns = map[storage.WalletSort]string{
+	storage.WalletSortID:              "id",
+	storage.WalletSortName:            "name",
+	storage.WalletSortCurrentHashrate: "current_hashrate",
+	storage.WalletSortAverageHashrate: "average_hashrate",
+	storage.WalletSortCreatedAt:       "created_at",
+}
+
+func (r *WalletRepository) ListWallets(ctx context.Context, filter storage.WalletFilter, page storage.WalletPage) ([]entity.Wallet, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	column, ok := walletSortColumns[page.Sort]
+	if !ok {
+		return nil, fmt.Errorf("unknown wallet sort %q", page.Sort)
+	}
+
+	conds := []string{"deleted_at IS NULL"}
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.CoinID > 0 {
+		conds = append(conds, "coin_id = "+arg(filter.CoinID))
+	}
+	if filter.RewardMethod != "" {
+		conds = append(conds, "reward_method = "+arg(filter.RewardMethod))
+	}
+	if filter.NamePrefix != "" {
+		conds = append(conds, "name LIKE "+arg(escapeLike(filter.NamePrefix)+"%"))
+	}
+	if filter.MinHashrate != nil {
+		conds = append(conds, "current_hashrate >= "+arg(*filter.MinHashrate))
+	}
+	if filter.MaxHashrate != nil {
+		conds = append(conds, "current_hashrate <= "+arg(*filter.MaxHashrate))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		conds = append(conds, "created_at >= "+arg(filter.CreatedFrom.UTC()))
+	}
+	if !filter.CreatedTo.IsZero() {
+		conds = append(conds, "created_at < "+arg(filter.CreatedTo.UTC()))
+	}
+
+	direction, cmp := "ASC", ">"
+	if page.Desc {
+		direction, cmp = "DESC", "<"
+	}
+
+	if page.After != nil {
+		if page.Sort == storage.WalletSortID {
+			conds = append(conds, "id "+cmp+" "+arg(page.After.ID))
+		} else {
+			value := walletSortValue(*page.After, page.Sort)
+			conds = append(conds, fmt.Sprintf("(%s, id) %s (%s, %s)", column, cmp, arg(value), arg(page.After.ID)))
+		}
+	}
+
+	query := `SELECT ` + walletColumns + ` FROM wallets WHERE ` + strings.Join(conds, " AND ")
+	if page.Sort == storage.WalletSortID {
+		query += " ORDER BY id " + direction
+	} else {
+		query += fmt.Sprintf(" ORDER BY %s %s, id %s", column, direction, direction)
+	}
+	if page.Limit > 0 {
+		query += " LIMIT " + arg(page.Limit)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	wallets := make([]entity.Wallet, 0)
+	for rows.Next() {
+		w, err := scanWallet(rows)
+		if err != nil {
+			return nil, err
+		}
+		wallets = append(wallets, w)
+	}
+
+	return wallets, rows.Err()
+}
+
+// walletColumns колонки кошелька в порядке scanWallet
+const walletColumns = `id, coin_id, name, reward_method, current_hashrate, average_hashrate, created_at, payment_threshold`
+
+func scanWallet(row pgx.Row) (entity.Wallet, error) {
+	var w entity.Wallet
+	err := row.Scan(&w.ID, &w.CoinID, &w.Name, &w.RewardMethod, &w.CurrentHashrate, &w.AverageHashrate, &w.CreatedAt, numeric{&w.PaymentThreshold})
+
+	return w, err
+}
+
+func (r *WalletRepository) GetWallet(ctx context.Context, id int64) (entity.Wallet, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	w, err := scanWallet(r.pool.QueryRow(ctx, `SELECT `+walletColumns+` FROM wallets WHERE id = $1 AND deleted_at IS NULL`, id))
+	if err != nil {
+		return entity.Wallet{}, wrapNoRows(err)
+	}
+
+	return w, nil
+}
+
+func (r *WalletRepository) SetPaymentThreshold(ctx context.Context, change entity.PaymentThresholdChange) (entity.PaymentThresholdChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	err := r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		change, err = setPaymentThreshold(ctx, tx, change)
+		return err
+	})
+	if err != nil {
+		return entity.PaymentThresholdChange{}, err
+	}
+
+	return change, nil
+}
+
+// setPaymentThreshold изменение порога выплаты с записью в журнал в транзакции tx
+// (также используется при подтверждении изменения настроек)
+func setPaymentThreshold(ctx context.Context, tx pgx.Tx, change entity.PaymentThresholdChange) (entity.PaymentThresholdChange, error) {
+	change.ChangedAt = time.Now()
+	now := change.ChangedAt.Format("2006-01-02 15:04:05.000")
+
+	// блокировка кошелька - чтобы в журнале старое значение совпадало с предыдущей записью
+	var changed bool
+	err := tx.QueryRow(ctx, `SELECT payment_threshold, payment_threshold <> $2::numeric, $2::numeric(20,6) 
+			FROM wallets WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`,
+		change.WalletID, numericArg(change.NewValue)).Scan(numeric{&change.OldValue}, &changed, numeric{&change.NewValue})
+	if err != nil {
+		return change, wrapNoRows(err)
+	}
+	if !changed {
+		return change, nil
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE wallets SET payment_threshold = $2::numeric WHERE id = $1`, change.WalletID, numericArg(change.NewValue)); err != nil {
+		return change, err
+	}
+
+	err = tx.QueryRow(ctx, `INSERT INTO payment_threshold_history (wallet_id, old_value, new_value, service, actor, changed_at) 
+			VALUES ($1, $2::numeric, $3::numeric, $4, $5, $6) 
+			RETURNING id`,
+		change.WalletID, numericArg(change.OldValue), numericArg(change.NewValue), change.Service, change.Actor, now).Scan(&change.ID)
+
+	return change, err
+}
+
+func (r *WalletRepository) ListPaymentThresholdChanges(ctx context.Context, walletID int64, limit int) ([]entity.PaymentThresholdChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	rows, err := r.pool.Query(ctx, `SELECT id, wallet_id, old_value, new_value, service, actor, changed_at 
+			FROM payment_threshold_history WHERE wallet_id = $1 
+			ORDER BY changed_at DESC, id DESC 
+			LIMIT $2`,
+		walletID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]entity.PaymentThresholdChange, 0)
+	for rows.Next() {
+		var c entity.PaymentThresholdChange
+		if err := rows.Scan(&c.ID, &c.WalletID, numeric{&c.OldValue}, numeric{&c.NewValue}, &c.Service, &c.Actor, &c.ChangedAt); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+
+	return changes, rows.Err()
+}
+
+// walletSortValue значение поля сортировки кошелька (для условия keyset пагинации)
+func walletSortValue(w entity.Wallet, sort storage.WalletSort) interface{} {
+	switch sort {
+	case storage.WalletSortName:
+		return w.Name
+	case storage.WalletSortCurrentHashrate:
+		return w.CurrentHashrate
+	case storage.WalletSortAverageHashrate:
+		return w.AverageHashrate
+	case storage.WalletSortCreatedAt:
+		return w.CreatedAt.UTC()
+	}
+	return w.ID
+}
+
+// escapeLike экранирование спецсимволов шаблона LIKE (экранирующий символ по умолчанию - обратная косая черта)
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
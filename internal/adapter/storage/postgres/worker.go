@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// WorkerRepository Postgresql реализация storage.WorkerRepository
+type WorkerRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewWorkerRepository(pool *pgxpool.Pool) *WorkerRepository {
+	return &WorkerRepository{
+		pool: pool,
+	}
+}
+
+func (r *WorkerRepository) GetWorkerByName(ctx context.Context, workerfull string, coinID int64, rewardMethod entity.RewardMethod) (entity.Worker, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	var w entity.Worker
+	err := r.pool.QueryRow(ctx, `SELECT id, coin_id, workerfull, wallet, worker, server_id, COALESCE(ip, ''), reward_method 
+			FROM workers WHERE workerfull = $1 AND coin_id = $2 AND reward_method = $3 AND deleted_at IS NULL`,
+		workerfull, coinID, rewardMethod).Scan(&w.ID, &w.CoinID, &w.Workerfull, &w.Wallet, &w.Worker, &w.ServerID, &w.IP, &w.RewardMethod)
+	if err != nil {
+		return entity.Worker{}, wrapNoRows(err)
+	}
+
+	return w, nil
+}
+
+func (r *WorkerRepository) CreateWorker(ctx context.Context, worker entity.Worker) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	now := time.Now().Format("2006-01-02 15:04:05.000")
+
+	// ON CONFLICT DO UPDATE (а не DO NOTHING) - чтобы RETURNING вернул id уже существующей записи,
+	// у переподключившегося воркера обновляются IP, пул-сервер, время обновления и история адресов (одним запросом)
+	// is_solo заполняется по методу начисления до удаления колонки
+	var newID int64
+	err := r.pool.QueryRow(ctx, `WITH w AS (
+				INSERT INTO workers (coin_id, workerfull, wallet, worker, server_id, ip, created_at, updated_at, is_solo, reward_method) 
+				VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $7, $8, $9) 
+				ON CONFLICT (workerfull, coin_id, reward_method) WHERE deleted_at IS NULL DO UPDATE SET ip = COALESCE(EXCLUDED.ip, workers.ip), 
+				updated_at = EXCLUDED.updated_at, server_id = EXCLUDED.server_id 
+				RETURNING id
+			), h AS (
+				INSERT INTO ip_history (worker_id, ip, first_seen, last_seen) 
+				SELECT id, $6, $7, $7 FROM w WHERE $6 <> '' 
+				ON CONFLICT (worker_id, ip) DO UPDATE SET last_seen = EXCLUDED.last_seen
+			)
+			SELECT id FROM w`,
+		worker.CoinID, worker.Workerfull, worker.Wallet, worker.Worker, worker.ServerID, worker.IP, now, worker.RewardMethod.IsSolo(), worker.RewardMethod).Scan(&newID)
+	if err != nil {
+		return 0, err
+	}
+
+	return newID, nil
+}
+
+func (r *WorkerRepository) GetWorkerIPHistory(ctx context.Context, workerID int64, limit int) ([]entity.WorkerIP, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	// LEFT JOIN - чтобы отличить воркера без истории от несуществующего
+	rows, err := r.pool.Query(ctx, `SELECT h.ip, h.first_seen, h.last_seen 
+			FROM workers w LEFT JOIN LATERAL (
+				SELECT ip, first_seen, last_seen FROM ip_history 
+				WHERE worker_id = w.id ORDER BY last_seen DESC LIMIT $2
+			) h ON true 
+			WHERE w.id = $1 AND w.deleted_at IS NULL`,
+		workerID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	found := false
+	history := make([]entity.WorkerIP, 0)
+	for rows.Next() {
+		found = true
+		var ip *string
+		var firstSeen, lastSeen *time.Time
+		if err := rows.Scan(&ip, &firstSeen, &lastSeen); err != nil {
+			return nil, err
+		}
+		if ip == nil {
+			continue // истории нет
+		}
+		history = append(history, entity.WorkerIP{IP: *ip, FirstSeen: *firstSeen, LastSeen: *lastSeen})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, wrapNoRows(pgx.ErrNoRows)
+	}
+
+	return history, nil
+}
+
+func (r *WorkerRepository) ListWorkersByWallet(ctx context.Context, filter storage.WorkerFilter, page storage.WorkerPage) ([]entity.Worker, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.QueryDealine*time.Second)
+	defer cancel()
+
+	// $5: NULL - все воркеры, иначе только с is_connect = $5; $6: 0 - без ограничения
+	rows, err := r.pool.Query(ctx, `SELECT id, coin_id, workerfull, wallet, worker, server_id, COALESCE(ip, ''), reward_method, 
+				is_connect, current_hashrate, average_hashrate, last_share_date, current_diff, miner_client, 
+				COALESCE(reported_hashrate, 0), reported_hashrate_date 
+			FROM workers 
+			WHERE wallet = $1 AND coin_id = $2 AND reward_method = $3 AND id > $4 AND deleted_at IS NULL 
+				AND ($5::boolean IS NULL OR is_connect = $5) 
+			ORDER BY id 
+			LIMIT NULLIF($6::integer, 0)`,
+		filter.Wallet, filter.CoinID, filter.RewardMethod, page.AfterID, filter.Online, page.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	workers := make([]entity.Worker, 0)
+	for rows.Next() {
+		var w entity.Worker
+		var lastShareDate, reportedHashrateDate *time.Time
+		if err := rows.Scan(&w.ID, &w.CoinID, &w.Workerfull, &w.Wallet, &w.Worker, &w.ServerID, &w.IP, &w.RewardMethod,
+			&w.IsConnect, &w.CurrentHashrate, &w.AverageHashrate, &lastShareDate, numeric{&w.CurrentDiff}, &w.MinerClient,
+			&w.ReportedHashrate, &reportedHashrateDate); err != nil {
+			return nil, err
+		}
+		if lastShareDate != nil {
+			w.LastShareDate = *lastShareDate
+		}
+		if reportedHashrateDate != nil {
+			w.ReportedHashrateDate = *reportedHashrateDate
+		}
+		workers = append(workers, w)
+	}
+
+	return workers, rows.Err()
+}
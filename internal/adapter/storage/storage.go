@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// ErrNotFound запись не найдена
+var ErrNotFound = errors.New("not found")
+
+// ErrAlreadyExists запись с таким уникальным значением уже есть
+var ErrAlreadyExists = errors.New("already exists")
+
+// ErrWorkersMismatch объединяемые воркеры относятся к разным кошелькам (монетам, методам начисления)
+var ErrWorkersMismatch = errors.New("workers belong to different wallets")
+
+// CoinRepository доступ к справочнику монет
+type CoinRepository interface {
+	// GetCoinIDBySymbol получение ID монеты по символу (тикеру) или альтернативному символу без учета регистра
+	// (совпадение с основным символом приоритетнее), выключенные монеты - только при includeInactive
+	GetCoinIDBySymbol(ctx context.Context, symbol string, includeInactive bool) (int64, error)
+	// CoinExists проверка существования монеты по ID
+	CoinExists(ctx context.Context, id int64) (bool, error)
+	// ListCoins монеты справочника по возрастанию ID
+	ListCoins(ctx context.Context, activeOnly bool) ([]entity.Coin, error)
+	// GetCoin монета по ID
+	GetCoin(ctx context.Context, id int64) (entity.Coin, error)
+	// CreateCoin добавление монеты (ErrAlreadyExists - символ или альтернативный символ занят без учета регистра),
+	// монете доступны все методы начисления вознаграждения
+	CreateCoin(ctx context.Context, coin entity.Coin) (int64, error)
+	// UpdateCoin изменение описания монеты (без is_active, params и статистических полей)
+	UpdateCoin(ctx context.Context, coin entity.Coin) error
+	// SetCoinActive включение/выключение монеты
+	SetCoinActive(ctx context.Context, id int64, active bool) error
+	// GetCoinParams дополнительные параметры монеты
+	GetCoinParams(ctx context.Context, id int64) (entity.CoinParams, error)
+	// PatchCoinParams атомарное изменение отдельных параметров монеты, возвращает параметры после изменения
+	PatchCoinParams(ctx context.Context, id int64, patch entity.CoinParamsPatch) (entity.CoinParams, error)
+}
+
+// WalletRepository доступ к кошелькам (майнерам)
+type WalletRepository interface {
+	// GetWalletByName получение кошелька по имени, монете и методу начисления вознаграждения
+	GetWalletByName(ctx context.Context, name string, coinID int64, rewardMethod entity.RewardMethod) (entity.Wallet, error)
+	// CreateWallet создание кошелька, если его еще нет (атомарно, безопасно при конкурентных вызовах)
+	// возвращает ID новой или уже существующей записи
+	CreateWallet(ctx context.Context, wallet entity.Wallet) (int64, error)
+	// ListWallets кошельки по условиям отбора (одна страница)
+	ListWallets(ctx context.Context, filter WalletFilter, page WalletPage) ([]entity.Wallet, error)
+	// GetWallet кошелек по ID со всеми полями (ErrNotFound - кошелька нет)
+	GetWallet(ctx context.Context, id int64) (entity.Wallet, error)
+	// SetPaymentThreshold изменение порога выплаты кошелька с записью в журнал (значение проверяется вызывающим),
+	// возвращает запись журнала (без записи, если значение не изменилось; ErrNotFound - кошелька нет)
+	SetPaymentThreshold(ctx context.Context, change entity.PaymentThresholdChange) (entity.PaymentThresholdChange, error)
+	// ListPaymentThresholdChanges журнал изменений порога выплаты кошелька, последние сначала
+	ListPaymentThresholdChanges(ctx context.Context, walletID int64, limit int) ([]entity.PaymentThresholdChange, error)
+}
+
+// WorkerRepository доступ к воркерам
+type WorkerRepository interface {
+	// GetWorkerByName получение воркера по полному имени, монете и методу начисления вознаграждения
+	GetWorkerByName(ctx context.Context, workerfull string, coinID int64, rewardMethod entity.RewardMethod) (entity.Worker, error)
+	// CreateWorker создание воркера, если его еще нет (атомарно, безопасно при конкурентных вызовах)
+	// возвращает ID новой или уже существующей записи, у существующей обновляется IP (если передан)
+	CreateWorker(ctx context.Context, worker entity.Worker) (int64, error)
+	// GetWorkerIPHistory адреса подключения воркера, последние сначала (ErrNotFound - воркера нет)
+	GetWorkerIPHistory(ctx context.Context, workerID int64, limit int) ([]entity.WorkerIP, error)
+	// ListWorkersByWallet воркеры кошелька по возрастанию ID (одна страница)
+	ListWorkersByWallet(ctx context.Context, filter WorkerFilter, page WorkerPage) ([]entity.Worker, error)
+}
+
+// WorkerStatsUpdater обновление состояния воркеров
+type WorkerStatsUpdater interface {
+	// UpdateWorkerStats пакетное обновление состояния воркеров (одной транзакцией) со сводкой хешрейта по их кошелькам,
+	// возвращает количество обновленных воркеров (неизвестные ID пропускаются) и изменения состояния подключения
+	UpdateWorkerStats(ctx context.Context, stats []entity.WorkerStats) (int, []entity.WorkerStatusEvent, error)
+	// MarkWorkersOffline отключение воркеров монеты, от которых не было шар с момента silentSince,
+	// возвращает события отключения
+	MarkWorkersOffline(ctx context.Context, coinID int64, silentSince time.Time) ([]entity.WorkerStatusEvent, error)
+}
+
+// SettingsChangeRepository изменения настроек кошельков, ожидающие подтверждения
+type SettingsChangeRepository interface {
+	// CreateSettingsChange новое ожидающее изменение (ErrNotFound - кошелька нет),
+	// ранее запрошенные неподтвержденные изменения той же настройки кошелька отменяются
+	CreateSettingsChange(ctx context.Context, change entity.SettingsChange) (int64, error)
+	// ConfirmSettingsChange атомарное применение изменения по хешу токена (одной транзакцией с отметкой о подтверждении),
+	// ErrNotFound - токен неизвестен, уже использован, отменен или истек
+	// изменение порога выплаты проверяется по ограничениям монеты на момент подтверждения (entity.ErrPaymentThresholdOutOfRange)
+	// и пишется в журнал от имени service и пользователя, запросившего изменение
+	ConfirmSettingsChange(ctx context.Context, tokenHash string, service string) (entity.SettingsChange, error)
+}
+
+// MinerResolver пакетное получение ID монет, кошельков и воркеров
+type MinerResolver interface {
+	// ResolveMiners возвращает ID для каждого элемента miners (в том же порядке),
+	// недостающие кошельки и воркеры создаются в одной транзакции.
+	// Для неизвестной монеты возвращаются нулевые ID, кошелек и воркер не создаются
+	ResolveMiners(ctx context.Context, miners []entity.MinerIdentity) ([]entity.MinerIDs, error)
+}
+
+// MinerRemover мягкое удаление и объединение кошельков и воркеров
+// удаленные записи не находятся ни по имени, ни по ID, при повторном подключении с тем же именем создается новая запись
+type MinerRemover interface {
+	// DeleteWallet удаление кошелька вместе с его воркерами (одной транзакцией), ожидающие изменения настроек отменяются,
+	// возвращает удаленный кошелек и его воркеров (ErrNotFound - кошелька нет или он уже удален)
+	DeleteWallet(ctx context.Context, walletID int64) (entity.Wallet, []entity.Worker, error)
+	// DeleteWorker удаление воркера с пересчетом хешрейта его кошелька, возвращает удаленного воркера
+	DeleteWorker(ctx context.Context, workerID int64) (entity.Worker, error)
+	// MergeWorkers объединение воркера sourceID с targetID одной транзакцией: история адресов и хешрейт переносятся в target,
+	// source удаляется со ссылкой на target (ErrWorkersMismatch - воркеры разных кошельков), возвращает удаленного source
+	MergeWorkers(ctx context.Context, sourceID int64, targetID int64) (entity.Worker, error)
+}
+
+// ShareKeyRepository ключи принятых шар (UUID и nonce воркера) для поиска повторов в пределах окна времени
+type ShareKeyRepository interface {
+	// ClaimShareKeys атомарное занятие ключей: новые ключи и ключи, записанные раньше since, записываются
+	// со временем seenAt; возвращает занятые ключи (остальные уже заняты, в том числе параллельным запросом)
+	ClaimShareKeys(ctx context.Context, keys []string, seenAt time.Time, since time.Time) ([]string, error)
+	// ReleaseShareKeys удаление ключей, занятых ClaimShareKeys со временем seenAt (занятые позже не удаляются)
+	ReleaseShareKeys(ctx context.Context, keys []string, seenAt time.Time) error
+	// DeleteShareKeys удаление ключей, записанных раньше before, возвращает количество удаленных
+	DeleteShareKeys(ctx context.Context, before time.Time) (int64, error)
+}
+
+// RewardMethodRepository справочник методов начисления вознаграждения
+type RewardMethodRepository interface {
+	// ListRewardMethodsByCoin методы, доступные для монеты
+	ListRewardMethodsByCoin(ctx context.Context, coinID int64) ([]entity.RewardMethodInfo, error)
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/dnsoftware/mpm-save-get-shares/pkg/logger"
 	"github.com/dnsoftware/mpm-save-get-shares/pkg/utils"
@@ -23,7 +25,18 @@ import (
 	"github.com/dnsoftware/mpm-miners-processor/config"
 	pb "github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc"
 	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	protov2 "github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto/v2"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/validation"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/sharesprocessor"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage/cache"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage/postgres"
 	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
+	"github.com/dnsoftware/mpm-miners-processor/internal/dedup"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+	"github.com/dnsoftware/mpm-miners-processor/internal/metrics"
+	"github.com/dnsoftware/mpm-miners-processor/internal/monitor"
+	"github.com/dnsoftware/mpm-miners-processor/internal/reward"
 	"github.com/dnsoftware/mpm-miners-processor/pkg/certmanager"
 	jwtauth "github.com/dnsoftware/mpm-miners-processor/pkg/jwt"
 )
@@ -78,11 +91,14 @@ func Run(ctx context.Context, cfg config.Config) {
 	if err != nil {
 		log.Fatalf("DiscoverAllServices error: %s", err.Error())
 	}
-	_ = baseUrls
+	for key, addr := range baseUrls {
+		cfg.ServiceDiscoveryList[key] = addr
+	}
 	logger.Log().Info("All services discovered")
 
-	// инициализируем BaseURLs доступа к API внешних сервисов
-	cfg.GRPCConfig.SharesProcessor = cfg.ServiceDiscoveryList[cfg.GRPCConfig.SharesProcessor]
+	// инициализируем BaseURLs доступа к API внешних сервисов (ключи сохраняем для повторного получения адресов)
+	sharesProcessorKey := cfg.GRPCConfig.SharesProcessor
+	cfg.GRPCConfig.SharesProcessor = cfg.ServiceDiscoveryList[sharesProcessorKey]
 
 	m, err := migrate.New(
 		"file://"+basePath+"/"+constants.MigrationDir,
@@ -104,18 +120,118 @@ func Run(ctx context.Context, cfg config.Config) {
 		logger.Log().Fatal("NewCertManager error: " + err.Error())
 	}
 
-	// Создаем gRPC-сервер
+	// Репозитории (с кэшем ID, если он включен в конфиге)
+	var coinRepo storage.CoinRepository = postgres.NewCoinRepository(pool)
+	var walletRepo storage.WalletRepository = postgres.NewWalletRepository(pool)
+	var workerRepo storage.WorkerRepository = postgres.NewWorkerRepository(pool)
+	var rewardMethodRepo storage.RewardMethodRepository = postgres.NewRewardMethodRepository(pool)
+	var walletCache *cache.WalletRepository
+	var workerCache *cache.WorkerRepository
+	caches := make(map[string]interface{ Stats() cache.Stats })
+	if cfg.Cache.CoinSize > 0 {
+		c := cache.NewCoinRepository(coinRepo, cfg.Cache.CoinSize, cfg.Cache.NegativeTTL)
+		coinRepo, caches["coins"] = c, c
+	}
+	if cfg.Cache.WalletSize > 0 {
+		walletCache = cache.NewWalletRepository(walletRepo, cfg.Cache.WalletSize, cfg.Cache.NegativeTTL)
+		walletRepo, caches["wallets"] = walletCache, walletCache
+	}
+	if cfg.Cache.WorkerSize > 0 {
+		workerCache = cache.NewWorkerRepository(workerRepo, cfg.Cache.WorkerSize, cfg.Cache.NegativeTTL)
+		workerRepo, caches["workers"] = workerCache, workerCache
+	}
+	if cfg.Cache.CoinSize > 0 && cfg.Cache.RewardMethodTTL > 0 {
+		c := cache.NewRewardMethodRepository(rewardMethodRepo, cfg.Cache.CoinSize, cfg.Cache.RewardMethodTTL)
+		rewardMethodRepo, caches["reward_methods"] = c, c
+	}
+	// удаление сбрасывает записи удаленных кошельков и воркеров в кэшах
+	remover := cache.NewMinerRemover(postgres.NewMinerRemover(pool), walletCache, workerCache)
+	// пакетное создание сбрасывает отрицательные результаты для созданных кошельков и воркеров
+	miners := cache.NewMinerResolver(postgres.NewMinerResolver(pool), walletCache, workerCache)
+
+	// Создаем gRPC-сервер (сначала проверка JWT и прав на административные методы, затем проверка запроса,
+	// кроме читающих методов версии 1, появившихся до версии 2)
 	serverCreds, err := certManager.GetServerCredentials()
 	interceptor := jwt.GetValidateInterceptor()
+	streamInterceptor := jwt.GetValidateStreamInterceptor()
+	adminInterceptor := jwt.GetAdminInterceptor(cfg.JWTAdminServices, pb.AdminMethods...)
+	rateServices := append(append([]string{}, cfg.JWTAdminServices...), cfg.JWTRateServices...)
+	rateInterceptor := jwt.GetAdminInterceptor(rateServices, pb.RateMethods...)
+	relayInterceptor := jwt.GetAdminInterceptor(cfg.JWTRelayServices, pb.RelayMethods...)
+	validator := validation.NewValidator(coinRepo, pb.LegacyMethods...)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptor, adminInterceptor, rateInterceptor, relayInterceptor, validator.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(streamInterceptor, validator.StreamServerInterceptor()),
+		grpc.Creds(*serverCreds),
+	)
+
+	// Изменения состояния воркеров (из UpdateWorkerStats и фоновой проверки) рассылаются подписчикам WatchWorkerStatus
+	workerEvents := monitor.NewBroker()
+	workerStats := monitor.NewPublishingUpdater(postgres.NewWorkerStatsUpdater(pool), workerEvents)
+
+	// Клиент сервиса процессинга шар (те же сертификаты и JWT, что и у остальных сервисов).
+	// Контракт proto/sharesprocessor еще не сверен с сервисом mpm_shares_processor, поэтому клиент включается
+	// только явно заданным ключом grpc.shares_processor (пустой - SubmitShares отвечает codes.Unimplemented)
+	var shares pb.SharesForwarder
+	var sharesProcessor *sharesprocessor.Client
+	if sharesProcessorKey != "" {
+		clientCreds, err := certManager.GetClientCredentials()
+		if err != nil {
+			logger.Log().Fatal("GetClientCredentials error: " + err.Error())
+		}
+		sharesProcessor, err = sharesprocessor.NewClient(cfg.GRPCConfig.SharesProcessor,
+			grpc.WithTransportCredentials(*clientCreds),
+			grpc.WithUnaryInterceptor(jwt.GetClientInterceptor()),
+			grpc.WithStreamInterceptor(jwt.GetClientStreamInterceptor()),
+		)
+		if err != nil {
+			logger.Log().Fatal("Error create shares processor client: " + err.Error())
+		}
+		shares = sharesProcessor
+	} else {
+		logger.Log().Warn("Shares processor is not configured, SubmitShares is disabled")
+	}
+
+	// Поиск повторно присланных шар (ключи шар занимаются в БД, общей для всех экземпляров)
+	var duplicates *dedup.Detector
+	if cfg.Dedup.Window > 0 {
+		duplicates = dedup.NewDetector(postgres.NewShareKeyRepository(pool), cfg.Dedup.Window)
+	}
 
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(interceptor), grpc.Creds(*serverCreds))
-	minersServer, err := pb.NewGRPCServer(pool)
+	// Расчет стоимости шар по методам начисления
+	rewardParams, err := newRewardParams(cfg.Reward)
+	if err != nil {
+		logger.Log().Fatal("Reward config error: " + err.Error())
+	}
+
+	minersServer, err := pb.NewGRPCServer(pb.Dependencies{
+		Coins:           coinRepo,
+		Wallets:         walletRepo,
+		Workers:         workerRepo,
+		Miners:          miners,
+		RewardMethods:   rewardMethodRepo,
+		WorkerStats:     workerStats,
+		SettingsChanges: postgres.NewSettingsChangeRepository(pool),
+		Remover:         remover,
+		Shares:          shares,
+		Duplicates:      duplicates,
+		Rewards:         reward.NewDefaultRegistry(),
+		RewardParams:    rewardParams,
+		WorkerEvents:    workerEvents,
+	})
 	if err != nil {
 		logger.Log().Fatal("Error create NewGRPCServer: " + err.Error())
 	}
 
-	// Регистрируем сервис
+	minersServerV2, err := pb.NewGRPCServerV2(minersServer)
+	if err != nil {
+		logger.Log().Fatal("Error create NewGRPCServerV2: " + err.Error())
+	}
+
+	// Регистрируем сервис (обе версии)
 	proto.RegisterMinersServiceServer(grpcServer, minersServer)
+	protov2.RegisterMinersServiceServer(grpcServer, minersServerV2)
 
 	// Запускаем сервер на определенном порту
 	addrParts := strings.Split(cfg.ApiBaseUrls.Grps, ":")
@@ -131,6 +247,40 @@ func Run(ctx context.Context, cfg config.Config) {
 		}
 	}()
 
+	// Метрики Prometheus (отдельный HTTP-сервер, чтобы сбор метрик не зависел от JWT и TLS gRPC)
+	var metricsServer *http.Server
+	if cfg.ApiBaseUrls.Metrics != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		metricsServer = &http.Server{Addr: cfg.ApiBaseUrls.Metrics, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Log().Error("Metrics server error: " + err.Error())
+			}
+		}()
+	}
+
+	// Фоновые задачи (отключение молчащих воркеров, очистка ключей шар, слежение за адресами сервисов)
+	bgCtx, stopBackground := context.WithCancel(context.Background())
+	if cfg.OfflineDetector.Interval > 0 {
+		detector := monitor.NewOfflineDetector(coinRepo, workerStats, cfg.OfflineDetector.Interval,
+			cfg.OfflineDetector.SilenceWindow, cfg.OfflineDetector.CoinWindows)
+		go detector.Run(bgCtx)
+	}
+
+	if duplicates != nil {
+		cleanupInterval := cfg.Dedup.CleanupInterval
+		if cleanupInterval <= 0 {
+			cleanupInterval = cfg.Dedup.Window
+		}
+		go duplicates.Run(bgCtx, cleanupInterval)
+	}
+
+	// Адрес сервиса процессинга шар может смениться (перезапуск, перенос) - периодически перечитываем его
+	if sharesProcessor != nil {
+		go sharesProcessor.WatchAddress(bgCtx, sd, sharesProcessorKey, constants.SharesProcessorResolveInterval*time.Second)
+	}
+
 	// Настройка graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
@@ -138,7 +288,73 @@ func Run(ctx context.Context, cfg config.Config) {
 	<-quit
 	log.Println("Shutting down gRPC server...")
 
-	// Останавливаем сервер
+	// Останавливаем сервер (потоки WatchWorkerStatus сами не завершаются - закрываем подписки)
+	stopBackground()
+	workerEvents.Close()
 	grpcServer.GracefulStop()
 	logger.Log().Info("gRPC server stopped")
+
+	if metricsServer != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+			logger.Log().Error("Metrics server shutdown error: " + err.Error())
+		}
+		cancel()
+	}
+
+	// входящих запросов больше нет - закрываем исходящее соединение
+	if sharesProcessor != nil {
+		if err := sharesProcessor.Close(); err != nil {
+			logger.Log().Error("Shares processor client close error: " + err.Error())
+		}
+	}
+
+	for name, c := range caches {
+		st := c.Stats()
+		logger.Log().Info(fmt.Sprintf("Cache %s: hits %d (negative %d), misses %d, size %d", name, st.Hits, st.NegativeHits, st.Misses, st.Size))
+	}
+	if duplicates != nil {
+		for serverID, st := range duplicates.Stats() {
+			logger.Log().Info(fmt.Sprintf("Duplicate shares %s: %d of %d (%.4f)", serverID, st.Duplicates, st.Shares, st.DuplicateRate()))
+		}
+	}
+	logger.Log().Info(fmt.Sprintf("Deprecated is_solo requests: %d", minersServer.DeprecatedIsSoloCount()))
+}
+
+// newRewardParams условия расчета стоимости шар из конфигурации (комиссия пула общая для всех монет)
+func newRewardParams(cfg config.RewardConfig) (reward.ParamsByCoin, error) {
+	parse := func(name, v string) (entity.Decimal, error) {
+		if v == "" {
+			return entity.Decimal{}, nil
+		}
+		d, err := entity.ParseDecimal(v)
+		if err != nil {
+			return entity.Decimal{}, fmt.Errorf("%s: %w", name, err)
+		}
+		return d, nil
+	}
+
+	poolFee, err := parse("pool_fee", cfg.PoolFee)
+	if err != nil {
+		return reward.ParamsByCoin{}, err
+	}
+	params := reward.ParamsByCoin{
+		Default: reward.Params{PoolFee: poolFee},
+		Coins:   make(map[string]reward.Params, len(cfg.Coins)),
+	}
+	for symbol, c := range cfg.Coins {
+		p := reward.Params{PoolFee: poolFee}
+		if p.NetworkDifficulty, err = parse("coins."+symbol+".network_difficulty", c.NetworkDifficulty); err != nil {
+			return reward.ParamsByCoin{}, err
+		}
+		if p.BlockFees, err = parse("coins."+symbol+".block_fees", c.BlockFees); err != nil {
+			return reward.ParamsByCoin{}, err
+		}
+		if p.HashesPerDiff, err = parse("coins."+symbol+".hashes_per_diff", c.HashesPerDiff); err != nil {
+			return reward.ParamsByCoin{}, err
+		}
+		params.Coins[symbol] = p
+	}
+
+	return params, nil
 }
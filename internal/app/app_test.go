@@ -0,0 +1,49 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/mpm-miners-processor/config"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+	"github.com/dnsoftware/mpm-miners-processor/internal/reward"
+)
+
+func TestNewRewardParams(t *testing.T) {
+	params, err := newRewardParams(config.RewardConfig{
+		PoolFee: "0.01",
+		Coins: map[string]config.CoinRewardConfig{
+			"ALPH": {NetworkDifficulty: "1000", BlockFees: "0.5"},
+			"KAS":  {NetworkDifficulty: "1000"},
+		},
+	})
+	require.NoError(t, err)
+
+	registry := reward.NewDefaultRegistry()
+	costs := func(symbol string) (pps, fpps entity.Decimal) {
+		coin := entity.Coin{Symbol: symbol, CoinsInBlock: entity.MustParseDecimal("3")}
+		share := entity.Share{Sharedif: entity.MustParseDecimal("10")}
+
+		share.RewardMethod = entity.RewardMethodPPS
+		withPPS, err := registry.Calculate(share, coin, params.For(coin))
+		require.NoError(t, err)
+		share.RewardMethod = entity.RewardMethodFPPS
+		withFPPS, err := registry.Calculate(share, coin, params.For(coin))
+		require.NoError(t, err)
+
+		return *withPPS.Cost, *withFPPS.Cost
+	}
+
+	// с комиссиями транзакций FPPS больше PPS на долю комиссий: 10 / 1000 * 0.5 * 0.99
+	pps, fpps := costs("alph")
+	require.Equal(t, "0.029700000000000000", pps.String())
+	require.Equal(t, "0.034650000000000000", fpps.String())
+
+	// без комиссий FPPS совпадает с PPS
+	pps, fpps = costs("KAS")
+	require.True(t, pps.Equal(fpps))
+
+	_, err = newRewardParams(config.RewardConfig{Coins: map[string]config.CoinRewardConfig{"ALPH": {BlockFees: "abc"}}})
+	require.ErrorContains(t, err, "coins.ALPH.block_fees")
+}
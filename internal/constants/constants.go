@@ -27,3 +27,35 @@ const (
 )
 
 const MigrationDir = "migration" // папка с миграциями относительно корня проекта
+
+// gRPC API
+const (
+	ResolveMinersMaxBatch = 1000 // максимальное количество элементов в одном запросе ResolveMiners
+	SubmitSharesMaxBatch  = 1000 // максимальное количество шар в одном запросе SubmitShares
+
+	StreamResolveBatchSize     = 500 // максимальный размер пакета записи в БД при потоковом StreamResolveMiners
+	StreamResolveFlushInterval = 10  // время в миллисекундах, в течение которого набирается пакет StreamResolveMiners
+
+	WorkerIPHistoryMaxLimit = 100 // максимальное количество записей в ответе GetWorkerIPHistory
+	WorkerIPMaxLen          = 45  // размер колонок workers.ip и ip_history.ip (IPv4-mapped IPv6)
+
+	ListWalletsDefaultPageSize = 100  // размер страницы ListWallets по умолчанию
+	ListWalletsMaxPageSize     = 1000 // максимальный размер страницы ListWallets
+
+	ListWorkersDefaultPageSize = 100  // размер страницы ListWorkersByWallet по умолчанию
+	ListWorkersMaxPageSize     = 1000 // максимальный размер страницы ListWorkersByWallet
+
+	UpdateWorkerStatsMaxBatch = 10000 // максимальное количество воркеров в одном запросе UpdateWorkerStats
+
+	WatchWorkerStatusBuffer = 1024 // буфер событий одного клиента WatchWorkerStatus (при переполнении клиент отключается)
+
+	PaymentThresholdHistoryMaxLimit = 100 // максимальное количество записей в ответе ListPaymentThresholdChanges
+
+	SettingsChangeTTL = 30 // время в минутах, в течение которого действует токен подтверждения RequestSettingsChange
+)
+
+// Клиенты внешних сервисов
+const (
+	SharesProcessorResolveInterval = 10 // период в секундах повторного получения адреса сервиса процессинга шар из ServiceDiscovery
+	SharesProcessorCallTimeout     = 5  // время в секундах, за которое должна завершиться передача пакета шар
+)
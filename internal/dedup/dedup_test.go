@@ -0,0 +1,90 @@
+package dedup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage/memory"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+	"github.com/dnsoftware/mpm-miners-processor/internal/metrics"
+)
+
+// failingStore хранилище ключей, которое может стать недоступным
+type failingStore struct {
+	*memory.ShareKeyRepository
+	fail bool
+}
+
+func (s *failingStore) ClaimShareKeys(ctx context.Context, keys []string, seenAt time.Time, since time.Time) ([]string, error) {
+	if s.fail {
+		return nil, errors.New("connection refused")
+	}
+	return s.ShareKeyRepository.ClaimShareKeys(ctx, keys, seenAt, since)
+}
+
+func TestDetector(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.Local)
+
+	// два экземпляра сервиса с общим хранилищем ключей
+	store := &failingStore{ShareKeyRepository: memory.NewShareKeyRepository()}
+	d1 := NewDetector(store, 10*time.Minute)
+	d2 := NewDetector(store, 10*time.Minute)
+	for _, d := range []*Detector{d1, d2} {
+		d.now = func() time.Time { return now }
+	}
+
+	share := func(uuid string, workerID int64, nonce string) entity.Share {
+		return entity.Share{UUID: uuid, ServerID: "ALPH-1", CoinID: 4, WorkerID: workerID, Nonce: nonce}
+	}
+	accepted := []entity.Share{share("u1", 1, "n1"), share("u2", 2, "n1")}
+
+	claim, err := d1.Claim(ctx, accepted)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, false}, claim.Duplicates)
+
+	// повтор UUID или nonce воркера находится и другим экземпляром
+	claim, err = d2.Claim(ctx, []entity.Share{share("u1", 3, "n3"), share("u4", 1, "n1"), share("u5", 3, "n1")})
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, false}, claim.Duplicates)
+
+	// не переданные дальше шары освобождаются - повторная отправка не считается повтором
+	require.NoError(t, d2.Release(ctx, claim))
+	claim, err = d1.Claim(ctx, []entity.Share{share("u5", 3, "n1")})
+	require.NoError(t, err)
+	require.Equal(t, []bool{false}, claim.Duplicates)
+
+	// БД недоступна - проверка не выполняется, ключи не занимаются
+	store.fail = true
+	_, err = d1.Claim(ctx, []entity.Share{share("u6", 6, "n6")})
+	require.Error(t, err)
+	store.fail = false
+	claim, err = d2.Claim(ctx, []entity.Share{share("u6", 6, "n6")})
+	require.NoError(t, err)
+	require.Equal(t, []bool{false}, claim.Duplicates)
+
+	// окно истекло - ключи снова свободны
+	now = now.Add(11 * time.Minute)
+	claim, err = d2.Claim(ctx, accepted)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, false}, claim.Duplicates)
+
+	// устаревшие ключи удаляются из БД (u5 и n1 воркера 3, u6 и n6 воркера 6)
+	deleted, err := d1.Cleanup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), deleted)
+
+	d1.Observe("ALPH-1", 3, 1)
+	d1.Observe("ALPH-1", 1, 0)
+	st := d1.Stats()["ALPH-1"]
+	require.Equal(t, ServerStats{Shares: 4, Duplicates: 1}, st)
+	require.InDelta(t, 0.25, st.DuplicateRate(), 1e-9)
+	require.Zero(t, ServerStats{}.DuplicateRate())
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.ShareDuplicates.WithLabelValues("ALPH-1")))
+	require.Equal(t, float64(4), testutil.ToFloat64(metrics.SharesChecked.WithLabelValues("ALPH-1")))
+}
@@ -0,0 +1,167 @@
+package dedup
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dnsoftware/mpm-save-get-shares/pkg/logger"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+	"github.com/dnsoftware/mpm-miners-processor/internal/metrics"
+)
+
+// Detector поиск повторно присланных шар (пул-серверы переотправляют шары после переподключения)
+// Ключи шары - UUID и nonce воркера монеты. Хранилище ключей - таблица в БД, общая для всех экземпляров
+// сервиса: ключ занимается атомарно (INSERT ... ON CONFLICT), поэтому повтор, принятый другим экземпляром,
+// тоже находится. Без БД повторы не ищутся - пакет отклоняется целиком (как и при ошибке получения ID воркеров)
+type Detector struct {
+	store  storage.ShareKeyRepository
+	window time.Duration
+	now    func() time.Time
+
+	statsMu sync.Mutex
+	stats   map[string]ServerStats // ServerID => счетчики
+}
+
+// Claim результат проверки пакета шар
+type Claim struct {
+	Duplicates []bool    // признак повтора для каждой шары (в том же порядке)
+	keys       []string  // ключи, занятые в БД этой проверкой
+	at         time.Time // время занятия
+}
+
+// ServerStats счетчики проверенных шар пул-сервера
+type ServerStats struct {
+	Shares     uint64 // всего проверено шар
+	Duplicates uint64 // из них повторов
+}
+
+// DuplicateRate доля повторов
+func (s ServerStats) DuplicateRate() float64 {
+	if s.Shares == 0 {
+		return 0
+	}
+	return float64(s.Duplicates) / float64(s.Shares)
+}
+
+// NewDetector window - окно, в пределах которого шара считается повтором
+func NewDetector(store storage.ShareKeyRepository, window time.Duration) *Detector {
+	return &Detector{
+		store:  store,
+		window: window,
+		stats:  make(map[string]ServerStats),
+		now:    time.Now,
+	}
+}
+
+// Claim проверка шар с занятием их ключей в БД (шары должны быть с заполненными ID монеты и воркера):
+// шара - повтор, если хотя бы один ее ключ уже занят в пределах окна (в том числе другим экземпляром сервиса).
+// Если шары потом не удалось передать дальше, ключи освобождаются Release - иначе повторная отправка
+// пакета была бы отброшена. Повторы внутри shares не ищутся - это делает вызывающий
+func (d *Detector) Claim(ctx context.Context, shares []entity.Share) (Claim, error) {
+	now := d.now()
+	claim := Claim{Duplicates: make([]bool, len(shares)), at: now}
+
+	keys := make([]string, 0, 2*len(shares))
+	for _, s := range shares {
+		k := shareKeys(s)
+		keys = append(keys, k[0], k[1])
+	}
+	if len(keys) == 0 {
+		return claim, nil
+	}
+
+	claimed, err := d.store.ClaimShareKeys(ctx, keys, now, now.Add(-d.window))
+	if err != nil {
+		return Claim{}, fmt.Errorf("claim share keys: %w", err)
+	}
+
+	own := make(map[string]struct{}, len(claimed))
+	for _, key := range claimed {
+		own[key] = struct{}{}
+	}
+	for i, s := range shares {
+		for _, key := range shareKeys(s) {
+			if _, ok := own[key]; !ok {
+				claim.Duplicates[i] = true
+			}
+		}
+	}
+	claim.keys = claimed
+
+	return claim, nil
+}
+
+// Release освобождение ключей, занятых claim (шары не переданы дальше)
+// ключ освобождается, только если его не занял заново более поздний запрос
+func (d *Detector) Release(ctx context.Context, claim Claim) error {
+	if len(claim.keys) == 0 {
+		return nil
+	}
+	if err := d.store.ReleaseShareKeys(ctx, claim.keys, claim.at); err != nil {
+		return fmt.Errorf("release share keys: %w", err)
+	}
+
+	return nil
+}
+
+// Observe учет проверенных шар пул-сервера (повторы внутри запроса тоже учитываются)
+// счетчики экспортируются в Prometheus (общая статистика всех экземпляров), Stats - только этого экземпляра с момента запуска
+func (d *Detector) Observe(serverID string, shares int, duplicates int) {
+	metrics.SharesChecked.WithLabelValues(serverID).Add(float64(shares))
+	metrics.ShareDuplicates.WithLabelValues(serverID).Add(float64(duplicates))
+
+	d.statsMu.Lock()
+	defer d.statsMu.Unlock()
+
+	st := d.stats[serverID]
+	st.Shares += uint64(shares)
+	st.Duplicates += uint64(duplicates)
+	d.stats[serverID] = st
+}
+
+// Stats счетчики по пул-серверам (копия)
+func (d *Detector) Stats() map[string]ServerStats {
+	d.statsMu.Lock()
+	defer d.statsMu.Unlock()
+
+	stats := make(map[string]ServerStats, len(d.stats))
+	for serverID, st := range d.stats {
+		stats[serverID] = st
+	}
+
+	return stats
+}
+
+// Run периодическое удаление устаревших ключей из БД до отмены ctx
+func (d *Detector) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := d.Cleanup(ctx); err != nil && ctx.Err() == nil {
+				logger.Log().Error("share dedup cleanup: " + err.Error())
+			}
+		}
+	}
+}
+
+// Cleanup удаление из БД ключей старше окна, возвращает количество удаленных
+func (d *Detector) Cleanup(ctx context.Context) (int64, error) {
+	return d.store.DeleteShareKeys(ctx, d.now().Add(-d.window))
+}
+
+// shareKeys ключи шары: UUID и nonce воркера монеты
+func shareKeys(s entity.Share) [2]string {
+	return [2]string{
+		"uuid:" + s.UUID,
+		fmt.Sprintf("nonce:%d:%d:%s", s.CoinID, s.WorkerID, s.Nonce),
+	}
+}
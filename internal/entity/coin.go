@@ -1 +1,27 @@
 package entity
+
+// Coin монета из справочника coins
+// десятичные значения - Decimal (без потери точности numeric)
+type Coin struct {
+	ID                    int64
+	Symbol                string // символ (тикер)
+	Symbol2               string // альтернативный символ
+	Name                  string
+	Algo                  string // алгоритм майнинга
+	Image                 string
+	MinWithdraw           Decimal // минимальная сумма выплаты
+	TransactionsExplorer  string  // ссылка на обозреватель транзакций
+	BlockExplorer         string  // ссылка на обозреватель блоков
+	IsActive              bool
+	Params                CoinParams // дополнительные параметры
+	AverageRoundDiff      Decimal
+	AverageSoloRoundDiff  Decimal
+	CurrentEffort         Decimal
+	CoinsInBlock          Decimal // награда за блок
+	AveragePPSRoundDiff   Decimal
+	AverageEffort         Decimal
+	AverageLastEffort     Decimal
+	SeoTitle              string
+	LastRewardProcessedID int64    // ID последней обработанной награды
+	MaxPaymentThreshold   *Decimal // максимальный порог выплаты кошелька (nil - без ограничения)
+}
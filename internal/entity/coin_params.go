@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// currencyCodeRe код валюты курса (USD, EUR, USDT ...)
+var currencyCodeRe = regexp.MustCompile(`^[A-Z]{3,10}$`)
+
+// CoinParams дополнительные параметры монеты (coins.params, jsonb)
+// имена полей JSON совпадают с уже записанными в БД, значения - числа JSON (см. Decimal.MarshalJSON)
+type CoinParams struct {
+	CurrencyRates                     map[string]Decimal `json:"currencyRates"`                     // курсы монеты (код валюты => курс)
+	CurrentRewardPerGigahash          Decimal            `json:"currentRewardPerGigahash"`          // текущая доходность на гигахеш (PPLNS)
+	CurrentRewardPerGigahashSolo      Decimal            `json:"currentRewardPerGigahashSolo"`      // то же для SOLO
+	CurrentRewardPerGigahashPPS       Decimal            `json:"currentRewardPerGigahashPPS"`       // то же для PPS (монет в сутки за 1 GH/s)
+	CurrentRewardPerGigahashMinerstat Decimal            `json:"currentRewardPerGigahashMinerstat"` // доходность по данным minerstat
+}
+
+// CoinParamsPatch изменение отдельных параметров монеты (nil - параметр не меняется,
+// курсы валют добавляются/заменяются по коду, остальные курсы сохраняются)
+type CoinParamsPatch struct {
+	CurrencyRates                     map[string]Decimal
+	CurrentRewardPerGigahash          *Decimal
+	CurrentRewardPerGigahashSolo      *Decimal
+	CurrentRewardPerGigahashPPS       *Decimal
+	CurrentRewardPerGigahashMinerstat *Decimal
+}
+
+// Validate значения должны быть неотрицательными, коды валют - заглавными латинскими буквами
+func (p CoinParams) Validate() error {
+	if err := validateRates(p.CurrencyRates); err != nil {
+		return err
+	}
+
+	return validateParamValues(map[string]Decimal{
+		"currentRewardPerGigahash":          p.CurrentRewardPerGigahash,
+		"currentRewardPerGigahashSolo":      p.CurrentRewardPerGigahashSolo,
+		"currentRewardPerGigahashPPS":       p.CurrentRewardPerGigahashPPS,
+		"currentRewardPerGigahashMinerstat": p.CurrentRewardPerGigahashMinerstat,
+	})
+}
+
+// Validate проверка изменяемых значений (по тем же правилам, что и CoinParams)
+func (p CoinParamsPatch) Validate() error {
+	if err := validateRates(p.CurrencyRates); err != nil {
+		return err
+	}
+
+	return validateParamValues(p.Fields())
+}
+
+// Empty нет ни одного изменения
+func (p CoinParamsPatch) Empty() bool {
+	return len(p.CurrencyRates) == 0 && len(p.Fields()) == 0
+}
+
+// Apply параметры после применения изменений
+func (p CoinParamsPatch) Apply(params CoinParams) CoinParams {
+	if len(p.CurrencyRates) > 0 {
+		rates := make(map[string]Decimal, len(params.CurrencyRates)+len(p.CurrencyRates))
+		for code, rate := range params.CurrencyRates {
+			rates[code] = rate
+		}
+		for code, rate := range p.CurrencyRates {
+			rates[code] = rate
+		}
+		params.CurrencyRates = rates
+	}
+	if p.CurrentRewardPerGigahash != nil {
+		params.CurrentRewardPerGigahash = *p.CurrentRewardPerGigahash
+	}
+	if p.CurrentRewardPerGigahashSolo != nil {
+		params.CurrentRewardPerGigahashSolo = *p.CurrentRewardPerGigahashSolo
+	}
+	if p.CurrentRewardPerGigahashPPS != nil {
+		params.CurrentRewardPerGigahashPPS = *p.CurrentRewardPerGigahashPPS
+	}
+	if p.CurrentRewardPerGigahashMinerstat != nil {
+		params.CurrentRewardPerGigahashMinerstat = *p.CurrentRewardPerGigahashMinerstat
+	}
+
+	return params
+}
+
+// Fields заданные скалярные параметры (имя JSON => значение), без курсов валют
+func (p CoinParamsPatch) Fields() map[string]Decimal {
+	fields := make(map[string]Decimal)
+	for name, v := range map[string]*Decimal{
+		"currentRewardPerGigahash":          p.CurrentRewardPerGigahash,
+		"currentRewardPerGigahashSolo":      p.CurrentRewardPerGigahashSolo,
+		"currentRewardPerGigahashPPS":       p.CurrentRewardPerGigahashPPS,
+		"currentRewardPerGigahashMinerstat": p.CurrentRewardPerGigahashMinerstat,
+	} {
+		if v != nil {
+			fields[name] = *v
+		}
+	}
+	return fields
+}
+
+func validateRates(rates map[string]Decimal) error {
+	for code, rate := range rates {
+		if !currencyCodeRe.MatchString(code) {
+			return fmt.Errorf("invalid currency code %q", code)
+		}
+		if rate.Sign() < 0 {
+			return fmt.Errorf("currencyRates.%s: value must be a non-negative number", code)
+		}
+	}
+	return nil
+}
+
+func validateParamValues(values map[string]Decimal) error {
+	for name, v := range values {
+		if v.Sign() < 0 {
+			return fmt.Errorf("%s: value must be a non-negative number", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinParamsPatch(t *testing.T) {
+	reward := MustParseDecimal("0.5")
+	negative := MustParseDecimal("-1")
+
+	tests := []struct {
+		name  string
+		patch CoinParamsPatch
+		valid bool
+	}{
+		{name: "rates", patch: CoinParamsPatch{CurrencyRates: map[string]Decimal{"USD": MustParseDecimal("2.06"), "USDT": MustParseDecimal("2.07")}}, valid: true},
+		{name: "reward", patch: CoinParamsPatch{CurrentRewardPerGigahash: &reward}, valid: true},
+		{name: "lowercase currency", patch: CoinParamsPatch{CurrencyRates: map[string]Decimal{"usd": MustParseDecimal("1")}}},
+		{name: "negative rate", patch: CoinParamsPatch{CurrencyRates: map[string]Decimal{"USD": MustParseDecimal("-1")}}},
+		{name: "negative reward", patch: CoinParamsPatch{CurrentRewardPerGigahashPPS: &negative}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.patch.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	params := CoinParams{
+		CurrencyRates:                     map[string]Decimal{"USD": MustParseDecimal("2"), "EUR": MustParseDecimal("1.8")},
+		CurrentRewardPerGigahashMinerstat: MustParseDecimal("0.04"),
+	}
+	patched := CoinParamsPatch{
+		CurrencyRates:            map[string]Decimal{"USD": MustParseDecimal("2.1")},
+		CurrentRewardPerGigahash: &reward,
+	}.Apply(params)
+
+	require.Equal(t, map[string]Decimal{"USD": MustParseDecimal("2.1"), "EUR": MustParseDecimal("1.8")}, patched.CurrencyRates)
+	require.Equal(t, "0.5", patched.CurrentRewardPerGigahash.String())
+	require.Equal(t, "0.04", patched.CurrentRewardPerGigahashMinerstat.String())
+	require.Equal(t, "2", params.CurrencyRates["USD"].String()) // исходные параметры не меняются
+
+	require.True(t, CoinParamsPatch{}.Empty())
+}
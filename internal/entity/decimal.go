@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal десятичное число без потери точности (numeric в БД, строка в API)
+// хранится целым коэффициентом и количеством знаков после запятой: масштаб сохраняется ("12.500000" остается "12.500000")
+// нулевое значение - 0
+type Decimal struct {
+	coef  *big.Int // nil - 0 (значение не изменяется после создания)
+	scale int32
+}
+
+// NewDecimal coef * 10^-scale (coef копируется)
+func NewDecimal(coef *big.Int, scale int32) Decimal {
+	if scale < 0 {
+		coef = new(big.Int).Mul(coef, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{coef: new(big.Int).Set(coef), scale: scale}
+}
+
+// ParseDecimal число вида [-]123[.456] (без экспоненты, пустая строка - ошибка)
+func ParseDecimal(s string) (Decimal, error) {
+	digits := strings.TrimPrefix(s, "-")
+	intPart, fracPart, hasPoint := strings.Cut(digits, ".")
+	if intPart == "" || (hasPoint && fracPart == "") || !onlyDigits(intPart) || !onlyDigits(fracPart) {
+		return Decimal{}, fmt.Errorf("%q is not a decimal number", s)
+	}
+
+	coef, _ := new(big.Int).SetString(intPart+fracPart, 10)
+	if len(digits) != len(s) {
+		coef.Neg(coef)
+	}
+
+	return Decimal{coef: coef, scale: int32(len(fracPart))}, nil
+}
+
+// MustParseDecimal ParseDecimal для констант и тестов (ошибка - panic)
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DecimalFromRat r с scale знаками после запятой, лишние знаки отбрасываются (округление к нулю)
+func DecimalFromRat(r *big.Rat, scale int32) Decimal {
+	n := new(big.Int).Mul(r.Num(), pow10(scale))
+	n.Quo(n, r.Denom())
+
+	return Decimal{coef: n, scale: scale}
+}
+
+// Coefficient целый коэффициент (копия): значение = Coefficient * 10^-Scale
+func (d Decimal) Coefficient() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(d.coef)
+}
+
+// Scale количество знаков после запятой
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Rat значение для вычислений
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.Coefficient(), pow10(d.scale))
+}
+
+// Sign -1, 0 или 1
+func (d Decimal) Sign() int {
+	if d.coef == nil {
+		return 0
+	}
+	return d.coef.Sign()
+}
+
+// IsZero значение равно 0 (при любом масштабе)
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp сравнение значений без учета масштаба (1.5 == 1.50)
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// Equal значения равны без учета масштаба
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Rescale значение с scale знаками после запятой (при уменьшении масштаба лишние знаки отбрасываются)
+func (d Decimal) Rescale(scale int32) Decimal {
+	switch {
+	case scale == d.scale:
+		return d
+	case scale > d.scale:
+		return Decimal{coef: new(big.Int).Mul(d.Coefficient(), pow10(scale-d.scale)), scale: scale}
+	default:
+		return Decimal{coef: new(big.Int).Quo(d.Coefficient(), pow10(d.scale-scale)), scale: scale}
+	}
+}
+
+// String запись с сохранением масштаба ("0.50", "-3", "12.500000")
+func (d Decimal) String() string {
+	coef := d.Coefficient()
+	digits := new(big.Int).Abs(coef).String()
+	sign := ""
+	if coef.Sign() < 0 {
+		sign = "-"
+	}
+	if d.scale == 0 {
+		return sign + digits
+	}
+
+	scale := int(d.scale)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+// MarshalText запись строкой (YAML и т.п., в JSON - числом, см. MarshalJSON)
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// MarshalJSON запись числом JSON (jsonb хранит числа как numeric - без потери точности)
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON чтение числа JSON (в том числе с экспонентой - так записаны старые параметры монет) или строки, null - 0
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	switch {
+	case s == "null":
+		*d = Decimal{}
+		return nil
+	case strings.HasPrefix(s, `"`):
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return d.UnmarshalText([]byte(text))
+	}
+
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(s), "e")
+	v, err := ParseDecimal(mantissa)
+	if err != nil {
+		return err
+	}
+	if hasExponent {
+		exp, err := strconv.ParseInt(strings.TrimPrefix(exponent, "+"), 10, 16)
+		if err != nil {
+			return fmt.Errorf("%q is not a decimal number", s)
+		}
+		v = NewDecimal(v.Coefficient(), v.scale-int32(exp))
+	}
+	*d = v
+
+	return nil
+}
+
+// UnmarshalText чтение строки (см. ParseDecimal)
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+func onlyDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
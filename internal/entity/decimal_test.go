@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in    string
+		want  string
+		scale int32
+		valid bool
+	}{
+		{in: "0", want: "0", valid: true},
+		{in: "12.500000", want: "12.500000", scale: 6, valid: true},
+		{in: "-0.001", want: "-0.001", scale: 3, valid: true},
+		{in: "007", want: "7", valid: true},
+		{in: "123456789012345678901234567890.123456789012345678", want: "123456789012345678901234567890.123456789012345678", scale: 18, valid: true},
+		{in: ""},
+		{in: "-"},
+		{in: ".5"},
+		{in: "5."},
+		{in: "1e3"},
+		{in: "+1"},
+		{in: "1.2.3"},
+		{in: " 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := ParseDecimal(tt.in)
+			if !tt.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, d.String())
+			require.Equal(t, tt.scale, d.Scale())
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	var zero Decimal
+	require.Equal(t, "0", zero.String())
+	require.True(t, zero.IsZero())
+	require.True(t, MustParseDecimal("0.000").IsZero())
+
+	require.True(t, MustParseDecimal("1.5").Equal(MustParseDecimal("1.50")))
+	require.Equal(t, -1, MustParseDecimal("-2").Cmp(MustParseDecimal("0.1")))
+	require.Equal(t, 1, MustParseDecimal("100.000001").Cmp(MustParseDecimal("100")))
+
+	require.Equal(t, "1.50000", MustParseDecimal("1.5").Rescale(5).String())
+	require.Equal(t, "-1.23", MustParseDecimal("-1.2399").Rescale(2).String())
+	require.Equal(t, "0.05", MustParseDecimal("0.05").Rescale(2).String())
+
+	// отрицательный масштаб раскрывается в целое число
+	require.Equal(t, "1000", NewDecimal(big.NewInt(1), -3).String())
+	require.Equal(t, "-0.05", NewDecimal(big.NewInt(-5), 2).String())
+
+	require.Equal(t, "0.666", DecimalFromRat(big.NewRat(2, 3), 3).String())
+	require.Equal(t, "-0.666", DecimalFromRat(big.NewRat(-2, 3), 3).String())
+	require.Equal(t, "3", DecimalFromRat(big.NewRat(7, 2), 0).String())
+
+	var d Decimal
+	require.NoError(t, d.UnmarshalText([]byte("0.10")))
+	text, err := d.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "0.10", string(text))
+	require.Error(t, d.UnmarshalText([]byte("abc")))
+
+	// JSON - числом, при чтении допускаются экспонента и строка
+	data, err := json.Marshal(map[string]Decimal{"v": MustParseDecimal("0.0462121797100464")})
+	require.NoError(t, err)
+	require.Equal(t, `{"v":0.0462121797100464}`, string(data))
+
+	for text, want := range map[string]string{
+		`0.0462121797100464`:   "0.0462121797100464",
+		`4.079819027607387e-6`: "0.000004079819027607387",
+		`1.5E+3`:               "1500",
+		`"2.50"`:               "2.50",
+		`null`:                 "0",
+	} {
+		var v Decimal
+		require.NoError(t, json.Unmarshal([]byte(text), &v), text)
+		require.Equal(t, want, v.String(), text)
+	}
+	require.Error(t, json.Unmarshal([]byte(`"1e3"`), &d))
+	require.Error(t, json.Unmarshal([]byte(`1e99999`), &d))
+}
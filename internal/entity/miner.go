@@ -0,0 +1,19 @@
+package entity
+
+// MinerIdentity идентификационные данные воркера в том виде, как они приходят с пул-сервера
+type MinerIdentity struct {
+	Coin         string       // символ монеты
+	Wallet       string       // имя кошелька (майнера)
+	Workerfull   string       // полное имя воркера
+	Worker       string       // имя воркера (без имени кошелька)
+	ServerID     string       // идентификатор пул-сервера (типа ALEPH-1 и т.п.)
+	IP           string       // IP адрес воркера
+	RewardMethod RewardMethod // код метода распределения наград
+}
+
+// MinerIDs идентификаторы монеты, кошелька и воркера
+type MinerIDs struct {
+	CoinID   int64
+	WalletID int64
+	WorkerID int64
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PaymentThresholdPrecision, PaymentThresholdScale общее количество цифр и количество знаков после запятой
+// порога выплаты (как в колонке wallets.payment_threshold numeric(20,6))
+const (
+	PaymentThresholdPrecision = 20
+	PaymentThresholdScale     = 6
+)
+
+// ErrPaymentThresholdOutOfRange порог выплаты вне допустимого для монеты диапазона
+var ErrPaymentThresholdOutOfRange = errors.New("payment threshold out of range")
+
+// PaymentThresholdChange запись журнала изменений порога выплаты кошелька
+type PaymentThresholdChange struct {
+	ID        int64
+	WalletID  int64
+	OldValue  Decimal
+	NewValue  Decimal
+	Service   string // сервис, выполнивший изменение (из JWT)
+	Actor     string // пользователь, от имени которого выполнено изменение (передается сервисом)
+	ChangedAt time.Time
+}
+
+// ValidatePaymentThreshold порог выплаты value (неотрицательный, не больше PaymentThresholdScale знаков после запятой)
+// для монеты coin: 0 - порог не задан (выплата от coin.MinWithdraw), иначе не меньше coin.MinWithdraw
+// и не больше coin.MaxPaymentThreshold (если задан)
+func ValidatePaymentThreshold(value Decimal, coin Coin) error {
+	if value.Sign() < 0 {
+		return fmt.Errorf("%w: %s is negative", ErrPaymentThresholdOutOfRange, value)
+	}
+	if value.Scale() > PaymentThresholdScale {
+		return fmt.Errorf("%w: at most %d decimal places allowed", ErrPaymentThresholdOutOfRange, PaymentThresholdScale)
+	}
+	if len(value.Rescale(0).Coefficient().String()) > PaymentThresholdPrecision-PaymentThresholdScale {
+		return fmt.Errorf("%w: at most %d integer digits allowed", ErrPaymentThresholdOutOfRange, PaymentThresholdPrecision-PaymentThresholdScale)
+	}
+	if value.Sign() == 0 {
+		return nil
+	}
+
+	if value.Cmp(coin.MinWithdraw) < 0 {
+		return fmt.Errorf("%w: must be at least min withdraw %s", ErrPaymentThresholdOutOfRange, coin.MinWithdraw)
+	}
+	if coin.MaxPaymentThreshold != nil && value.Cmp(*coin.MaxPaymentThreshold) > 0 {
+		return fmt.Errorf("%w: must be at most %s", ErrPaymentThresholdOutOfRange, coin.MaxPaymentThreshold)
+	}
+
+	return nil
+}
+
+// EffectivePaymentThreshold порог, от которого выполняется выплата (не заданный порог - минимальная сумма выплаты монеты)
+func EffectivePaymentThreshold(value Decimal, coin Coin) Decimal {
+	if value.Sign() > 0 {
+		return value
+	}
+	return coin.MinWithdraw
+}
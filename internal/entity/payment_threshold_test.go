@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePaymentThreshold(t *testing.T) {
+	maxThreshold := MustParseDecimal("100")
+	coin := Coin{MinWithdraw: MustParseDecimal("0.500000"), MaxPaymentThreshold: &maxThreshold}
+
+	tests := []struct {
+		name  string
+		value string
+		coin  Coin
+		valid bool
+	}{
+		{name: "not set", value: "0", coin: coin, valid: true},
+		{name: "min withdraw", value: "0.5", coin: coin, valid: true},
+		{name: "max", value: "100.000000", coin: coin, valid: true},
+		{name: "below min withdraw", value: "0.4", coin: coin, valid: false},
+		{name: "above max", value: "100.000001", coin: coin, valid: false},
+		{name: "no max", value: "1000", coin: Coin{MinWithdraw: MustParseDecimal("0.5")}, valid: true},
+		{name: "max digits", value: "00099999999999999.999999", coin: Coin{MinWithdraw: MustParseDecimal("0.5")}, valid: true},
+		{name: "too many digits", value: "100000000000000", coin: Coin{MinWithdraw: MustParseDecimal("0.5")}, valid: false},
+		{name: "scale", value: "1.1234567", coin: coin, valid: false},
+		{name: "negative", value: "-1", coin: coin, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePaymentThreshold(MustParseDecimal(tt.value), tt.coin)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrPaymentThresholdOutOfRange)
+			}
+		})
+	}
+
+	require.Equal(t, "0.500000", EffectivePaymentThreshold(MustParseDecimal("0.000000"), coin).String())
+	require.Equal(t, "2", EffectivePaymentThreshold(MustParseDecimal("2"), coin).String())
+}
@@ -0,0 +1,44 @@
+package entity
+
+// RewardMethod код метода начисления вознаграждения (совпадает с reward_methods.code в БД)
+type RewardMethod string
+
+const (
+	RewardMethodPPLNS   RewardMethod = "PPLNS"
+	RewardMethodPPS     RewardMethod = "PPS"
+	RewardMethodPPSPlus RewardMethod = "PPS+"
+	RewardMethodFPPS    RewardMethod = "FPPS"
+	RewardMethodSOLO    RewardMethod = "SOLO"
+	RewardMethodPROP    RewardMethod = "PROP"
+)
+
+// RewardMethods все поддерживаемые пулом методы начисления вознаграждения
+var RewardMethods = []RewardMethod{
+	RewardMethodPPLNS,
+	RewardMethodPPS,
+	RewardMethodPPSPlus,
+	RewardMethodFPPS,
+	RewardMethodSOLO,
+	RewardMethodPROP,
+}
+
+// Valid метод есть в списке поддерживаемых
+func (m RewardMethod) Valid() bool {
+	for _, v := range RewardMethods {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSolo соло режим (замена устаревшего флага IsSolo)
+func (m RewardMethod) IsSolo() bool {
+	return m == RewardMethodSOLO
+}
+
+// RewardMethodInfo запись справочника методов начисления вознаграждения
+type RewardMethodInfo struct {
+	Code RewardMethod
+	Name string // название для отображения
+}
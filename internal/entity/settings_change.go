@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+// SettingsKind вид изменяемой настройки кошелька
+type SettingsKind string
+
+const (
+	SettingsKindPaymentThreshold SettingsKind = "payment_threshold" // порог выплаты (значение - десятичное число)
+)
+
+// SettingsChange изменение настройки кошелька, ожидающее подтверждения одноразовым токеном
+type SettingsChange struct {
+	ID          int64
+	WalletID    int64
+	Kind        SettingsKind
+	Value       string
+	TokenHash   string // SHA-256 токена подтверждения (сам токен не хранится)
+	Service     string // сервис, создавший запрос (из JWT)
+	Actor       string // пользователь, запросивший изменение
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	ConfirmedAt time.Time // нулевое - не подтверждено
+}
+
+// NewConfirmationToken случайный одноразовый токен подтверждения и его хеш для хранения
+func NewConfirmationToken() (token string, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+
+	return token, HashConfirmationToken(token), nil
+}
+
+// HashConfirmationToken хеш токена подтверждения (hex SHA-256)
+func HashConfirmationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
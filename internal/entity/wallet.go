@@ -1,9 +1,15 @@
 package entity
 
+import "time"
+
 type Wallet struct {
-	ID           int64
-	CoinID       int64
-	Name         string
-	IsSolo       bool   // оставлено для совместимости TODO убрать
-	RewardMethod string // строковый код метода распределения наград
+	ID              int64
+	CoinID          int64
+	Name            string
+	RewardMethod    RewardMethod // код метода распределения наград
+	CurrentHashrate int64        // текущий хешрейт (заполняется при выборке списка)
+	AverageHashrate int64        // средний хешрейт (заполняется при выборке списка)
+	CreatedAt       time.Time    // время создания (UTC)
+
+	PaymentThreshold Decimal // порог выплаты (0 - не задан, выплата от минимальной суммы монеты)
 }
@@ -1,13 +1,58 @@
 package entity
 
+import "time"
+
+// CurrentDiffScale знаков после запятой текущей сложности воркера (как в колонке numeric(25,10))
+const CurrentDiffScale int32 = 10
+
 type Worker struct {
 	ID           int64
 	CoinID       int64
-	Workerfull   string // полное имя воркера
-	Wallet       string // имя кошелька (майнера)
-	Worker       string // имя воркера (без имени кошелька)
-	ServerID     string // идентификатор пул-сервера (типа ALEPH-1 и т.п.)
-	IP           string // IP адрес воркера
-	IsSolo       bool   // оставлено для совместимости TODO убрать
-	RewardMethod string // строковый код метода распределения наград
+	Workerfull   string       // полное имя воркера
+	Wallet       string       // имя кошелька (майнера)
+	Worker       string       // имя воркера (без имени кошелька)
+	ServerID     string       // идентификатор пул-сервера (типа ALEPH-1 и т.п.)
+	IP           string       // IP адрес воркера
+	RewardMethod RewardMethod // код метода распределения наград
+
+	// состояние воркера (заполняется при выборке списка)
+	IsConnect       bool      // воркер подключен (онлайн)
+	CurrentHashrate int64     // текущий хешрейт
+	AverageHashrate int64     // средний хешрейт
+	LastShareDate   time.Time // время последней шары (нулевое - шар не было)
+	CurrentDiff     Decimal   // текущая сложность
+	MinerClient     string    // майнинговая программа воркера
+
+	ReportedHashrate     int64     // хешрейт по данным майнинговой программы
+	ReportedHashrateDate time.Time // время получения ReportedHashrate (нулевое - не передавался)
+}
+
+// WorkerIP адрес, с которого подключался воркер
+type WorkerIP struct {
+	IP        string
+	FirstSeen time.Time // первое подключение с этого адреса
+	LastSeen  time.Time // последнее подключение с этого адреса
+}
+
+// WorkerStats текущее состояние воркера (передается сервисом обработки шар)
+type WorkerStats struct {
+	WorkerID         int64
+	IsConnect        bool
+	CurrentHashrate  int64
+	AverageHashrate  int64
+	LastShareDate    time.Time // нулевое - не изменяется
+	CurrentDiff      *Decimal  // nil - не изменяется
+	ReportedHashrate *int64    // хешрейт по данным майнинговой программы, nil - не изменяется
+}
+
+// WorkerStatusEvent изменение состояния подключения воркера
+type WorkerStatusEvent struct {
+	WorkerID      int64
+	CoinID        int64
+	Wallet        string
+	Workerfull    string
+	RewardMethod  RewardMethod
+	Online        bool      // true - воркер подключился, false - отключился
+	LastShareDate time.Time // время последней шары (нулевое - шар не было)
+	Time          time.Time // время изменения состояния
 }
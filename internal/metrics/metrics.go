@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "miners_processor"
+
+// DeprecatedFieldRequests запросы с устаревшими полями по методу, полю и клиенту
+// (когда счетчик поля перестает расти - поле можно удалять, см. migration/deferred)
+var DeprecatedFieldRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "deprecated_field_requests_total",
+	Help:      "Requests that still send a deprecated field.",
+}, []string{"method", "field", "client"})
+
+// SharesChecked, ShareDuplicates проверенные на повтор шары и найденные повторы по пул-серверам
+var (
+	SharesChecked = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "shares_checked_total",
+		Help:      "Shares checked for duplicates by pool server.",
+	}, []string{"server_id"})
+
+	ShareDuplicates = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "share_duplicates_total",
+		Help:      "Duplicate shares by pool server.",
+	}, []string{"server_id"})
+)
+
+func init() {
+	prometheus.MustRegister(DeprecatedFieldRequests, SharesChecked, ShareDuplicates)
+}
+
+// Handler метрики в формате Prometheus (для /metrics)
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
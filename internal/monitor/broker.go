@@ -0,0 +1,106 @@
+package monitor
+
+import (
+	"sync"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// Broker рассылка событий изменения состояния воркеров подписчикам
+// Подписчик, не успевающий читать события (буфер заполнен), отключается - канал подписки закрывается
+// с признаком переполнения, после переподключения подписчик должен сам сверить состояние воркеров
+type Broker struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+// Subscription подписка на события
+type Subscription struct {
+	C        <-chan entity.WorkerStatusEvent // закрывается при отписке, переполнении или остановке брокера
+	ch       chan entity.WorkerStatusEvent
+	overflow bool
+}
+
+// Overflow подписка закрыта из-за переполнения буфера (читать только после закрытия C)
+func (s *Subscription) Overflow() bool {
+	return s.overflow
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subs: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe новая подписка с буфером на buffer событий
+func (b *Broker) Subscribe(buffer int) *Subscription {
+	ch := make(chan entity.WorkerStatusEvent, buffer)
+	sub := &Subscription{C: ch, ch: ch}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		close(ch)
+		return sub
+	}
+	b.subs[sub] = struct{}{}
+
+	return sub
+}
+
+// Unsubscribe отписка (повторный вызов безопасен)
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// Publish рассылка событий всем подписчикам (не блокируется)
+func (b *Broker) Publish(events ...entity.WorkerStatusEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs {
+	send:
+		for _, e := range events {
+			select {
+			case sub.ch <- e:
+			default:
+				sub.overflow = true
+				delete(b.subs, sub)
+				close(sub.ch)
+				break send
+			}
+		}
+	}
+}
+
+// Len количество подписчиков
+func (b *Broker) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subs)
+}
+
+// Close закрытие всех подписок (при остановке сервиса, чтобы завершились потоки WatchWorkerStatus)
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subs {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
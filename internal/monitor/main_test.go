@@ -0,0 +1,16 @@
+package monitor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dnsoftware/mpm-save-get-shares/pkg/logger"
+)
+
+func TestMain(m *testing.M) {
+	// в модульных тестах нет .env (корня проекта) - лог пишется во временный каталог
+	logger.InitLogger(logger.LogLevelProduction, filepath.Join(os.TempDir(), "mpm-miners-processor-test.log"))
+
+	os.Exit(m.Run())
+}
@@ -0,0 +1,107 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage/memory"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+func TestBroker(t *testing.T) {
+	b := NewBroker()
+
+	sub := b.Subscribe(2)
+	slow := b.Subscribe(1)
+
+	b.Publish(entity.WorkerStatusEvent{WorkerID: 1}, entity.WorkerStatusEvent{WorkerID: 2})
+	require.Equal(t, int64(1), (<-sub.C).WorkerID)
+	require.Equal(t, int64(2), (<-sub.C).WorkerID)
+
+	// медленный подписчик отключен с признаком переполнения
+	<-slow.C
+	_, ok := <-slow.C
+	require.False(t, ok)
+	require.True(t, slow.Overflow())
+	b.Unsubscribe(slow) // повторная отписка безопасна
+
+	b.Close()
+	_, ok = <-sub.C
+	require.False(t, ok)
+	require.False(t, sub.Overflow())
+
+	// подписка после остановки сразу закрыта
+	_, ok = <-b.Subscribe(1).C
+	require.False(t, ok)
+}
+
+func TestOfflineDetector(t *testing.T) {
+	ctx := context.Background()
+
+	coins := memory.NewCoinRepository(map[string]int64{"ALPH": 4, "KAS": 8})
+	wallets := memory.NewWalletRepository()
+	workers := memory.NewWorkerRepository()
+
+	broker := NewBroker()
+	sub := broker.Subscribe(16)
+	updater := NewPublishingUpdater(memory.NewWorkerStatsUpdater(wallets, workers), broker)
+
+	ids := make(map[string]int64)
+	for _, w := range []entity.Worker{
+		{CoinID: 4, Workerfull: "a.rig", Wallet: "a", Worker: "rig", RewardMethod: entity.RewardMethodPPLNS},
+		{CoinID: 8, Workerfull: "k.rig", Wallet: "k", Worker: "rig", RewardMethod: entity.RewardMethodPPLNS},
+	} {
+		id, err := workers.CreateWorker(ctx, w)
+		require.NoError(t, err)
+		ids[w.Workerfull] = id
+	}
+	walletID, err := wallets.CreateWallet(ctx, entity.Wallet{CoinID: 4, Name: "a", RewardMethod: entity.RewardMethodPPLNS})
+	require.NoError(t, err)
+
+	// подключение воркеров - события online
+	updated, events, err := updater.UpdateWorkerStats(ctx, []entity.WorkerStats{
+		{WorkerID: ids["a.rig"], IsConnect: true, CurrentHashrate: 10},
+		{WorkerID: ids["k.rig"], IsConnect: true, CurrentHashrate: 20},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, updated)
+	require.Len(t, events, 2)
+	for i := 0; i < 2; i++ {
+		require.True(t, (<-sub.C).Online)
+	}
+
+	// повторное обновление без смены состояния - событий нет
+	_, events, err = updater.UpdateWorkerStats(ctx, []entity.WorkerStats{{WorkerID: ids["a.rig"], IsConnect: true, CurrentHashrate: 11}})
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// через 10 минут молчания: у ALPH окно 5 минут, у KAS (общее) - час
+	d := NewOfflineDetector(coins, updater, time.Minute, time.Hour, map[string]time.Duration{"alph": 5 * time.Minute})
+	d.now = func() time.Time { return time.Now().Add(10 * time.Minute) }
+
+	n, err := d.Check(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	e := <-sub.C
+	require.False(t, e.Online)
+	require.Equal(t, ids["a.rig"], e.WorkerID)
+	require.Equal(t, "a", e.Wallet)
+
+	// у отключенного воркера и его кошелька текущий хешрейт обнуляется
+	list, err := workers.ListWorkersByWallet(ctx, storage.WorkerFilter{Wallet: "a", CoinID: 4, RewardMethod: entity.RewardMethodPPLNS}, storage.WorkerPage{})
+	require.NoError(t, err)
+	require.Zero(t, list[0].CurrentHashrate)
+	wallet, err := wallets.GetWallet(ctx, walletID)
+	require.NoError(t, err)
+	require.Zero(t, wallet.CurrentHashrate)
+
+	// повторная проверка - воркер уже отключен
+	n, err = d.Check(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
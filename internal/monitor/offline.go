@@ -0,0 +1,86 @@
+package monitor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dnsoftware/mpm-save-get-shares/pkg/logger"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+)
+
+// OfflineDetector фоновое отключение воркеров, от которых долго не было шар
+// Окно молчания задается для каждой монеты отдельно (по символу), для остальных монет - общее
+type OfflineDetector struct {
+	coins       storage.CoinRepository
+	updater     storage.WorkerStatsUpdater
+	interval    time.Duration
+	window      time.Duration
+	coinWindows map[string]time.Duration // символ монеты в нижнем регистре => окно молчания
+	now         func() time.Time
+}
+
+// NewOfflineDetector interval - период проверки, window - окно молчания по умолчанию,
+// coinWindows - окна молчания отдельных монет (символ без учета регистра => окно)
+func NewOfflineDetector(coins storage.CoinRepository, updater storage.WorkerStatsUpdater,
+	interval time.Duration, window time.Duration, coinWindows map[string]time.Duration) *OfflineDetector {
+	windows := make(map[string]time.Duration, len(coinWindows))
+	for symbol, w := range coinWindows {
+		windows[strings.ToLower(symbol)] = w
+	}
+
+	return &OfflineDetector{
+		coins:       coins,
+		updater:     updater,
+		interval:    interval,
+		window:      window,
+		coinWindows: windows,
+		now:         time.Now,
+	}
+}
+
+// Run периодическая проверка до отмены ctx
+func (d *OfflineDetector) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := d.Check(ctx); err != nil && ctx.Err() == nil {
+				logger.Log().Error("offline detector: " + err.Error())
+			}
+		}
+	}
+}
+
+// Check одна проверка всех активных монет, возвращает количество отключенных воркеров
+func (d *OfflineDetector) Check(ctx context.Context) (int, error) {
+	coins, err := d.coins.ListCoins(ctx, true)
+	if err != nil {
+		return 0, err
+	}
+
+	now := d.now()
+	total := 0
+	for _, coin := range coins {
+		window, ok := d.coinWindows[strings.ToLower(coin.Symbol)]
+		if !ok {
+			window = d.window
+		}
+		if window <= 0 {
+			continue // для монеты проверка отключена
+		}
+
+		events, err := d.updater.MarkWorkersOffline(ctx, coin.ID, now.Add(-window))
+		if err != nil {
+			return total, err
+		}
+		total += len(events)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,42 @@
+package monitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage"
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// PublishingUpdater обертка над storage.WorkerStatsUpdater, рассылающая изменения состояния воркеров через Broker
+type PublishingUpdater struct {
+	next   storage.WorkerStatsUpdater
+	broker *Broker
+}
+
+func NewPublishingUpdater(next storage.WorkerStatsUpdater, broker *Broker) *PublishingUpdater {
+	return &PublishingUpdater{
+		next:   next,
+		broker: broker,
+	}
+}
+
+func (u *PublishingUpdater) UpdateWorkerStats(ctx context.Context, stats []entity.WorkerStats) (int, []entity.WorkerStatusEvent, error) {
+	updated, events, err := u.next.UpdateWorkerStats(ctx, stats)
+	if err != nil {
+		return 0, nil, err
+	}
+	u.broker.Publish(events...)
+
+	return updated, events, nil
+}
+
+func (u *PublishingUpdater) MarkWorkersOffline(ctx context.Context, coinID int64, silentSince time.Time) ([]entity.WorkerStatusEvent, error) {
+	events, err := u.next.MarkWorkersOffline(ctx, coinID, silentSince)
+	if err != nil {
+		return nil, err
+	}
+	u.broker.Publish(events...)
+
+	return events, nil
+}
@@ -0,0 +1,236 @@
+package reward
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+// CostScale количество знаков после запятой стоимости шары (остаток отбрасывается - пул не переплачивает)
+const CostScale int32 = 18
+
+// ErrUnsupportedRewardMethod для метода начисления нет калькулятора
+var ErrUnsupportedRewardMethod = errors.New("unsupported reward method")
+
+// ErrNoDifficulty не задана ни сложность сети, ни средняя сложность раунда монеты
+var ErrNoDifficulty = errors.New("difficulty is unknown")
+
+// Params условия расчета, которых нет в справочнике монет (0 - не задано)
+type Params struct {
+	NetworkDifficulty entity.Decimal // текущая сложность сети в единицах сложности шар
+	BlockFees         entity.Decimal // средняя сумма комиссий транзакций в блоке (учитывается FPPS)
+	PoolFee           entity.Decimal // комиссия пула, доля от 0 до 1 (0.015 - 1,5%)
+	HashesPerDiff     entity.Decimal // хешей на единицу сложности шары (зависит от алгоритма, нужно для ставки PPS)
+}
+
+// ParamsByCoin условия расчета по символу монеты (без учета регистра), Default - для остальных монет
+type ParamsByCoin struct {
+	Default Params
+	Coins   map[string]Params
+}
+
+// For условия расчета монеты
+func (p ParamsByCoin) For(coin entity.Coin) Params {
+	for symbol, params := range p.Coins {
+		if strings.EqualFold(symbol, coin.Symbol) {
+			return params
+		}
+	}
+
+	return p.Default
+}
+
+// RewardCalculator расчет стоимости шары по одному методу начисления вознаграждения
+type RewardCalculator interface {
+	// Method метод начисления, для которого выполняется расчет
+	Method() entity.RewardMethod
+	// ShareCost стоимость шары в монетах (с CostScale знаками после запятой)
+	ShareCost(share entity.Share, coin entity.Coin, params Params) (entity.Decimal, error)
+}
+
+// Registry набор калькуляторов по методам начисления
+type Registry struct {
+	calculators map[entity.RewardMethod]RewardCalculator
+}
+
+// NewRegistry калькулятор, переданный позже, заменяет ранее переданный для того же метода
+func NewRegistry(calculators ...RewardCalculator) *Registry {
+	r := &Registry{
+		calculators: make(map[entity.RewardMethod]RewardCalculator, len(calculators)),
+	}
+	for _, c := range calculators {
+		r.calculators[c.Method()] = c
+	}
+
+	return r
+}
+
+// NewDefaultRegistry PPS, FPPS и PPLNS
+func NewDefaultRegistry() *Registry {
+	return NewRegistry(PPS{}, FPPS{}, PPLNS{})
+}
+
+// Calculate шара с рассчитанной стоимостью по ее методу начисления
+func (r *Registry) Calculate(share entity.Share, coin entity.Coin, params Params) (entity.Share, error) {
+	c, ok := r.calculators[share.RewardMethod]
+	if !ok {
+		return share, fmt.Errorf("%w: %q", ErrUnsupportedRewardMethod, share.RewardMethod)
+	}
+
+	cost, err := c.ShareCost(share, coin, params)
+	if err != nil {
+		return share, err
+	}
+	share.Cost = &cost
+
+	return share, nil
+}
+
+// PPS (pay per share): шара оплачивается сразу по ставке PPS монеты (params.currentRewardPerGigahashPPS -
+// монет в сутки за 1 GH/s, уже за вычетом комиссии пула):
+// Sharedif * HashesPerDiff / (86400 * 10^9) * ставка
+// без ставки или HashesPerDiff - по доле в ожидаемой награде за блок без комиссий транзакций:
+// Sharedif / сложность * CoinsInBlock * (1 - комиссия пула)
+// без сложности сети используется средняя сложность PPS раунда монеты
+type PPS struct{}
+
+func (PPS) Method() entity.RewardMethod {
+	return entity.RewardMethodPPS
+}
+
+func (PPS) ShareCost(share entity.Share, coin entity.Coin, params Params) (entity.Decimal, error) {
+	in, err := parseInputs(share, coin, params)
+	if err != nil {
+		return entity.Decimal{}, err
+	}
+	rate := coin.Params.CurrentRewardPerGigahashPPS
+	if rate.Sign() < 0 {
+		return entity.Decimal{}, fmt.Errorf("currentRewardPerGigahashPPS: %s is negative", rate)
+	}
+	if rate.Sign() > 0 && in.hashesPerDiff.Sign() > 0 {
+		v := new(big.Rat).Mul(in.sharedif, in.hashesPerDiff)
+		v.Mul(v, rate.Rat())
+		v.Quo(v, gigahashDay)
+
+		return entity.DecimalFromRat(v, CostScale), nil
+	}
+	diff, err := difficulty(in.network, coin.AveragePPSRoundDiff, "average_pps_round_diff")
+	if err != nil {
+		return entity.Decimal{}, err
+	}
+
+	return cost(in.sharedif, in.blockReward, diff, in.poolFee), nil
+}
+
+// gigahashDay хешей за сутки при 1 GH/s
+var gigahashDay = new(big.Rat).SetInt64(86400 * 1_000_000_000)
+
+// FPPS (full pay per share): как PPS, но в награду за блок включаются средние комиссии транзакций
+// Sharedif / сложность * (CoinsInBlock + BlockFees) * (1 - комиссия пула)
+type FPPS struct{}
+
+func (FPPS) Method() entity.RewardMethod {
+	return entity.RewardMethodFPPS
+}
+
+func (FPPS) ShareCost(share entity.Share, coin entity.Coin, params Params) (entity.Decimal, error) {
+	in, err := parseInputs(share, coin, params)
+	if err != nil {
+		return entity.Decimal{}, err
+	}
+	diff, err := difficulty(in.network, coin.AveragePPSRoundDiff, "average_pps_round_diff")
+	if err != nil {
+		return entity.Decimal{}, err
+	}
+	if params.BlockFees.Sign() < 0 {
+		return entity.Decimal{}, fmt.Errorf("block fees: %s is negative", params.BlockFees)
+	}
+	fees := params.BlockFees.Rat()
+
+	return cost(in.sharedif, new(big.Rat).Add(in.blockReward, fees), diff, in.poolFee), nil
+}
+
+// PPLNS (pay per last N shares): окончательно награда делится при нахождении блока,
+// здесь - предварительная оценка по средней сложности раунда монеты (сумма сложностей шар за раунд)
+// Sharedif / AverageRoundDiff * CoinsInBlock * (1 - комиссия пула)
+// без средней сложности раунда используется сложность сети
+type PPLNS struct{}
+
+func (PPLNS) Method() entity.RewardMethod {
+	return entity.RewardMethodPPLNS
+}
+
+func (PPLNS) ShareCost(share entity.Share, coin entity.Coin, params Params) (entity.Decimal, error) {
+	in, err := parseInputs(share, coin, params)
+	if err != nil {
+		return entity.Decimal{}, err
+	}
+	diff := coin.AverageRoundDiff.Rat()
+	if diff.Sign() <= 0 {
+		if in.network.Sign() == 0 {
+			return entity.Decimal{}, fmt.Errorf("%w: neither average_round_diff nor network difficulty is set", ErrNoDifficulty)
+		}
+		diff = in.network
+	}
+
+	return cost(in.sharedif, in.blockReward, diff, in.poolFee), nil
+}
+
+// inputs общие для всех методов значения
+type inputs struct {
+	sharedif      *big.Rat
+	blockReward   *big.Rat
+	network       *big.Rat
+	poolFee       *big.Rat
+	hashesPerDiff *big.Rat
+}
+
+// parseInputs значения должны быть неотрицательными, комиссия пула - не больше 1
+func parseInputs(share entity.Share, coin entity.Coin, params Params) (inputs, error) {
+	for name, v := range map[string]entity.Decimal{
+		"sharedif":           share.Sharedif,
+		"coins_in_block":     coin.CoinsInBlock,
+		"network difficulty": params.NetworkDifficulty,
+		"pool fee":           params.PoolFee,
+		"hashes per diff":    params.HashesPerDiff,
+	} {
+		if v.Sign() < 0 {
+			return inputs{}, fmt.Errorf("%s: %s is negative", name, v)
+		}
+	}
+	if params.PoolFee.Cmp(entity.NewDecimal(big.NewInt(1), 0)) > 0 {
+		return inputs{}, fmt.Errorf("pool fee %s is greater than 1", params.PoolFee)
+	}
+
+	return inputs{
+		sharedif:      share.Sharedif.Rat(),
+		blockReward:   coin.CoinsInBlock.Rat(),
+		network:       params.NetworkDifficulty.Rat(),
+		poolFee:       params.PoolFee.Rat(),
+		hashesPerDiff: params.HashesPerDiff.Rat(),
+	}, nil
+}
+
+// difficulty сложность сети, а если она не задана - средняя сложность раунда монеты
+func difficulty(network *big.Rat, average entity.Decimal, name string) (*big.Rat, error) {
+	if network.Sign() > 0 {
+		return network, nil
+	}
+	if average.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: neither network difficulty nor %s is set", ErrNoDifficulty, name)
+	}
+
+	return average.Rat(), nil
+}
+
+// cost sharedif / diff * reward * (1 - poolFee), diff > 0
+func cost(sharedif, reward, diff, poolFee *big.Rat) entity.Decimal {
+	v := new(big.Rat).Mul(sharedif, reward)
+	v.Quo(v, diff)
+	v.Mul(v, new(big.Rat).Sub(big.NewRat(1, 1), poolFee))
+
+	return entity.DecimalFromRat(v, CostScale)
+}
@@ -0,0 +1,179 @@
+package reward
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/entity"
+)
+
+func TestShareCost(t *testing.T) {
+	coin := entity.Coin{
+		CoinsInBlock:        dec("3"),
+		AverageRoundDiff:    dec("6000"),
+		AveragePPSRoundDiff: dec("4000"),
+	}
+	ppsCoin := coin
+	ppsCoin.Params.CurrentRewardPerGigahashPPS = dec("0.5")
+
+	tests := []struct {
+		name     string
+		calc     RewardCalculator
+		sharedif string
+		coin     entity.Coin
+		params   Params
+		want     string
+		err      error
+	}{
+		{name: "PPS by network difficulty", calc: PPS{}, sharedif: "1000", coin: coin,
+			params: Params{NetworkDifficulty: dec("3000")}, want: "1.000000000000000000"},
+		{name: "PPS by average round", calc: PPS{}, sharedif: "1000", coin: coin,
+			want: "0.750000000000000000"},
+		{name: "PPS pool fee", calc: PPS{}, sharedif: "1000", coin: coin,
+			params: Params{NetworkDifficulty: dec("3000"), PoolFee: dec("0.015")}, want: "0.985000000000000000"},
+		{name: "PPS truncated", calc: PPS{}, sharedif: "1", coin: entity.Coin{CoinsInBlock: dec("2")},
+			params: Params{NetworkDifficulty: dec("3")}, want: "0.666666666666666666"},
+		{name: "PPS tiny share", calc: PPS{}, sharedif: "0.000001", coin: entity.Coin{CoinsInBlock: dec("0.5")},
+			params: Params{NetworkDifficulty: dec("1000000000000")}, want: "0.000000000000000000"},
+		{name: "PPS ignores fees", calc: PPS{}, sharedif: "1000", coin: coin,
+			params: Params{NetworkDifficulty: dec("3000"), BlockFees: dec("1")}, want: "1.000000000000000000"},
+		{name: "PPS by rate", calc: PPS{}, sharedif: "86400", coin: ppsCoin,
+			params: Params{NetworkDifficulty: dec("3000"), PoolFee: dec("0.015"), HashesPerDiff: dec("1000000000")}, want: "0.500000000000000000"},
+		{name: "PPS by rate truncated", calc: PPS{}, sharedif: "1", coin: entity.Coin{Params: entity.CoinParams{CurrentRewardPerGigahashPPS: dec("0.0462")}},
+			params: Params{HashesPerDiff: dec("4294967296")}, want: "0.000002296614456888"},
+		{name: "PPS rate without hashes per diff", calc: PPS{}, sharedif: "1000", coin: ppsCoin,
+			params: Params{NetworkDifficulty: dec("3000")}, want: "1.000000000000000000"},
+		{name: "PPS no difficulty", calc: PPS{}, sharedif: "1000", coin: entity.Coin{CoinsInBlock: dec("3")},
+			err: ErrNoDifficulty},
+		{name: "FPPS with fees", calc: FPPS{}, sharedif: "1000", coin: coin,
+			params: Params{NetworkDifficulty: dec("3000"), BlockFees: dec("0.3")}, want: "1.100000000000000000"},
+		{name: "FPPS without fees", calc: FPPS{}, sharedif: "1000", coin: coin,
+			params: Params{NetworkDifficulty: dec("3000")}, want: "1.000000000000000000"},
+		{name: "FPPS fees and pool fee", calc: FPPS{}, sharedif: "2048.5", coin: entity.Coin{CoinsInBlock: dec("6.25")},
+			params: Params{NetworkDifficulty: dec("81920000"), BlockFees: dec("0.75"), PoolFee: dec("0.02")}, want: "0.000171541870117187"},
+		{name: "PPLNS by average round", calc: PPLNS{}, sharedif: "1000", coin: coin,
+			params: Params{NetworkDifficulty: dec("3000")}, want: "0.500000000000000000"},
+		{name: "PPLNS by network difficulty", calc: PPLNS{}, sharedif: "1000", coin: entity.Coin{CoinsInBlock: dec("3")},
+			params: Params{NetworkDifficulty: dec("3000")}, want: "1.000000000000000000"},
+		{name: "PPLNS no difficulty", calc: PPLNS{}, sharedif: "1000", coin: entity.Coin{CoinsInBlock: dec("3")},
+			err: ErrNoDifficulty},
+		{name: "zero block reward", calc: PPLNS{}, sharedif: "1000", coin: entity.Coin{AverageRoundDiff: dec("10")},
+			want: "0.000000000000000000"},
+		{name: "large values exact", calc: PPS{}, sharedif: "123456789012345678901234567890", coin: entity.Coin{CoinsInBlock: dec("1")},
+			params: Params{NetworkDifficulty: dec("123456789012345678901234567890")}, want: "1.000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.calc.ShareCost(entity.Share{Sharedif: dec(tt.sharedif)}, tt.coin, tt.params)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestShareCostInvalidInput(t *testing.T) {
+	coin := entity.Coin{CoinsInBlock: dec("3"), AverageRoundDiff: dec("6000"), AveragePPSRoundDiff: dec("4000")}
+
+	tests := []struct {
+		name     string
+		sharedif string
+		coin     entity.Coin
+		params   Params
+	}{
+		{name: "negative sharedif", sharedif: "-1", coin: coin},
+		{name: "negative coins in block", sharedif: "1", coin: entity.Coin{CoinsInBlock: dec("-3"), AveragePPSRoundDiff: dec("1"), AverageRoundDiff: dec("1")}},
+		{name: "negative network difficulty", sharedif: "1", coin: coin, params: Params{NetworkDifficulty: dec("-5")}},
+		{name: "negative pool fee", sharedif: "1", coin: coin, params: Params{PoolFee: dec("-0.01")}},
+		{name: "pool fee above 1", sharedif: "1", coin: coin, params: Params{PoolFee: dec("1.5")}},
+		{name: "negative hashes per diff", sharedif: "1", coin: coin, params: Params{HashesPerDiff: dec("-1")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, calc := range []RewardCalculator{PPS{}, FPPS{}, PPLNS{}} {
+				_, err := calc.ShareCost(entity.Share{Sharedif: dec(tt.sharedif)}, tt.coin, tt.params)
+				require.Error(t, err, calc.Method())
+			}
+		})
+	}
+
+	// комиссии транзакций учитывает только FPPS
+	_, err := FPPS{}.ShareCost(entity.Share{Sharedif: dec("1")}, coin, Params{BlockFees: dec("-1")})
+	require.Error(t, err)
+	_, err = PPS{}.ShareCost(entity.Share{Sharedif: dec("1")}, coin, Params{BlockFees: dec("-1")})
+	require.NoError(t, err)
+
+	// ставку PPS учитывает только PPS
+	coin.Params.CurrentRewardPerGigahashPPS = dec("-1")
+	_, err = PPS{}.ShareCost(entity.Share{Sharedif: dec("1")}, coin, Params{})
+	require.Error(t, err)
+	_, err = PPLNS{}.ShareCost(entity.Share{Sharedif: dec("1")}, coin, Params{})
+	require.NoError(t, err)
+}
+
+func TestParamsByCoin(t *testing.T) {
+	p := ParamsByCoin{
+		Default: Params{PoolFee: dec("0.01")},
+		Coins:   map[string]Params{"alph": {PoolFee: dec("0.02")}},
+	}
+	require.Equal(t, "0.02", p.For(entity.Coin{Symbol: "ALPH"}).PoolFee.String())
+	require.Equal(t, "0.01", p.For(entity.Coin{Symbol: "KAS"}).PoolFee.String())
+}
+
+func TestRegistry(t *testing.T) {
+	coin := entity.Coin{CoinsInBlock: dec("3"), AverageRoundDiff: dec("6000"), AveragePPSRoundDiff: dec("4000")}
+	r := NewDefaultRegistry()
+
+	tests := []struct {
+		method entity.RewardMethod
+		want   string
+		err    error
+	}{
+		{method: entity.RewardMethodPPS, want: "0.750000000000000000"},
+		{method: entity.RewardMethodFPPS, want: "0.750000000000000000"},
+		{method: entity.RewardMethodPPLNS, want: "0.500000000000000000"},
+		{method: entity.RewardMethodSOLO, err: ErrUnsupportedRewardMethod},
+		{method: entity.RewardMethodPROP, err: ErrUnsupportedRewardMethod},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.method), func(t *testing.T) {
+			share, err := r.Calculate(entity.Share{UUID: "u1", Sharedif: dec("1000"), RewardMethod: tt.method}, coin, Params{})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.Nil(t, share.Cost)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "u1", share.UUID)
+			require.Equal(t, tt.want, share.Cost.String())
+		})
+	}
+
+	// калькулятор можно заменить своим
+	r = NewRegistry(PPS{}, fixedCalculator{method: entity.RewardMethodPPS, cost: dec("42")})
+	share, err := r.Calculate(entity.Share{Sharedif: dec("1"), RewardMethod: entity.RewardMethodPPS}, coin, Params{})
+	require.NoError(t, err)
+	require.Equal(t, "42", share.Cost.String())
+}
+
+type fixedCalculator struct {
+	method entity.RewardMethod
+	cost   entity.Decimal
+}
+
+func (c fixedCalculator) Method() entity.RewardMethod {
+	return c.method
+}
+
+func (c fixedCalculator) ShareCost(share entity.Share, coin entity.Coin, params Params) (entity.Decimal, error) {
+	return c.cost, nil
+}
+
+var dec = entity.MustParseDecimal
@@ -0,0 +1,2 @@
+ALTER TABLE public.workers DROP CONSTRAINT IF EXISTS workers_workerfull_coin_id_reward_method_unique;
+ALTER TABLE public.wallets DROP CONSTRAINT IF EXISTS wallets_name_coin_id_reward_method_unique;
@@ -0,0 +1,31 @@
+-- Удаление дубликатов кошельков (остается запись с минимальным id)
+
+DELETE FROM public.wallets a
+    USING public.wallets b
+WHERE a.name = b.name
+  AND a.coin_id = b.coin_id
+  AND a.reward_method = b.reward_method
+  AND a.id > b.id;
+
+-- Constraint: wallets_name_coin_id_reward_method_unique
+
+-- ALTER TABLE public.wallets DROP CONSTRAINT IF EXISTS wallets_name_coin_id_reward_method_unique;
+
+ALTER TABLE public.wallets
+    ADD CONSTRAINT wallets_name_coin_id_reward_method_unique UNIQUE (name, coin_id, reward_method);
+
+-- Удаление дубликатов воркеров (остается запись с минимальным id)
+
+DELETE FROM public.workers a
+    USING public.workers b
+WHERE a.workerfull = b.workerfull
+  AND a.coin_id = b.coin_id
+  AND a.reward_method = b.reward_method
+  AND a.id > b.id;
+
+-- Constraint: workers_workerfull_coin_id_reward_method_unique
+
+-- ALTER TABLE public.workers DROP CONSTRAINT IF EXISTS workers_workerfull_coin_id_reward_method_unique;
+
+ALTER TABLE public.workers
+    ADD CONSTRAINT workers_workerfull_coin_id_reward_method_unique UNIQUE (workerfull, coin_id, reward_method);
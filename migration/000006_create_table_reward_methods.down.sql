@@ -0,0 +1,4 @@
+ALTER TABLE public.workers DROP CONSTRAINT IF EXISTS workers_reward_method_foreign;
+ALTER TABLE public.wallets DROP CONSTRAINT IF EXISTS wallets_reward_method_foreign;
+DROP TABLE IF EXISTS public.coin_reward_methods;
+DROP TABLE IF EXISTS public.reward_methods;
@@ -0,0 +1,99 @@
+-- Table: public.reward_methods
+
+-- DROP TABLE IF EXISTS public.reward_methods;
+
+CREATE TABLE IF NOT EXISTS public.reward_methods
+(
+    code character varying(16) COLLATE pg_catalog."default" PRIMARY KEY,
+    name character varying(255) COLLATE pg_catalog."default" NOT NULL DEFAULT ''::character varying,
+    is_solo boolean NOT NULL DEFAULT false
+)
+
+    TABLESPACE pg_default;
+
+INSERT INTO public.reward_methods (code, name, is_solo) VALUES
+    ('PPLNS', 'Pay Per Last N Shares', false),
+    ('PPS', 'Pay Per Share', false),
+    ('PPS+', 'Pay Per Share Plus', false),
+    ('FPPS', 'Full Pay Per Share', false),
+    ('SOLO', 'Solo', true),
+    ('PROP', 'Proportional', false)
+ON CONFLICT (code) DO NOTHING;
+
+-- Table: public.coin_reward_methods (методы начисления вознаграждения, доступные для монеты)
+
+-- DROP TABLE IF EXISTS public.coin_reward_methods;
+
+CREATE TABLE IF NOT EXISTS public.coin_reward_methods
+(
+    coin_id bigint NOT NULL,
+    reward_method character varying(16) COLLATE pg_catalog."default" NOT NULL,
+    CONSTRAINT coin_reward_methods_pkey PRIMARY KEY (coin_id, reward_method),
+    CONSTRAINT coin_reward_methods_coin_id_foreign FOREIGN KEY (coin_id)
+        REFERENCES public.coins (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT coin_reward_methods_reward_method_foreign FOREIGN KEY (reward_method)
+        REFERENCES public.reward_methods (code) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE NO ACTION
+)
+
+    TABLESPACE pg_default;
+
+-- Существующим монетам доступны все методы
+
+INSERT INTO public.coin_reward_methods (coin_id, reward_method)
+SELECT c.id, m.code
+FROM public.coins c
+         CROSS JOIN public.reward_methods m
+ON CONFLICT DO NOTHING;
+
+-- Перенос устаревшего is_solo = true на метод SOLO
+-- (на кошелек/воркер переносится только одна запись, если SOLO запись с тем же именем уже есть - запись не меняется)
+
+UPDATE public.wallets w
+SET reward_method = 'SOLO'
+WHERE w.id IN (
+    SELECT DISTINCT ON (s.name, s.coin_id) s.id
+    FROM public.wallets s
+    WHERE s.is_solo
+      AND s.reward_method <> 'SOLO'
+      AND NOT EXISTS (SELECT 1
+                      FROM public.wallets d
+                      WHERE d.name = s.name
+                        AND d.coin_id = s.coin_id
+                        AND d.reward_method = 'SOLO')
+    ORDER BY s.name, s.coin_id, s.id
+);
+
+UPDATE public.workers w
+SET reward_method = 'SOLO'
+WHERE w.id IN (
+    SELECT DISTINCT ON (s.workerfull, s.coin_id) s.id
+    FROM public.workers s
+    WHERE s.is_solo
+      AND s.reward_method <> 'SOLO'
+      AND NOT EXISTS (SELECT 1
+                      FROM public.workers d
+                      WHERE d.workerfull = s.workerfull
+                        AND d.coin_id = s.coin_id
+                        AND d.reward_method = 'SOLO')
+    ORDER BY s.workerfull, s.coin_id, s.id
+);
+
+-- Новые записи - только с методом из справочника (существующие записи не проверяются)
+
+ALTER TABLE public.wallets
+    ADD CONSTRAINT wallets_reward_method_foreign FOREIGN KEY (reward_method)
+        REFERENCES public.reward_methods (code) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE NO ACTION
+        NOT VALID;
+
+ALTER TABLE public.workers
+    ADD CONSTRAINT workers_reward_method_foreign FOREIGN KEY (reward_method)
+        REFERENCES public.reward_methods (code) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE NO ACTION
+        NOT VALID;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS public.ip_history;
+
+-- не выполнится, если уже сохранены адреса длиннее 32 символов (IPv6)
+ALTER TABLE public.workers ALTER COLUMN ip TYPE character varying(32);
@@ -0,0 +1,40 @@
+-- Адрес воркера: IPv6 - до 39 символов, IPv4-mapped IPv6 - до 45
+
+ALTER TABLE public.workers ALTER COLUMN ip TYPE character varying(45);
+
+-- Table: public.ip_history (адреса, с которых подключался воркер)
+
+-- DROP TABLE IF EXISTS public.ip_history;
+
+CREATE TABLE IF NOT EXISTS public.ip_history
+(
+    id BIGSERIAL PRIMARY KEY,
+    worker_id bigint NOT NULL,
+    ip character varying(45) COLLATE pg_catalog."default" NOT NULL,
+    first_seen timestamp(3) without time zone NOT NULL,
+    last_seen timestamp(3) without time zone NOT NULL,
+    CONSTRAINT ip_history_worker_id_ip_unique UNIQUE (worker_id, ip),
+    CONSTRAINT ip_history_worker_id_foreign FOREIGN KEY (worker_id)
+        REFERENCES public.workers (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+)
+
+    TABLESPACE pg_default;
+
+-- Index: ip_history_worker_id_last_seen_index
+
+-- DROP INDEX IF EXISTS public.ip_history_worker_id_last_seen_index;
+
+CREATE INDEX IF NOT EXISTS ip_history_worker_id_last_seen_index
+    ON public.ip_history USING btree
+    (worker_id ASC NULLS LAST, last_seen DESC NULLS LAST)
+    TABLESPACE pg_default;
+
+-- Текущие адреса существующих воркеров
+
+INSERT INTO public.ip_history (worker_id, ip, first_seen, last_seen)
+SELECT id, ip, COALESCE(created_at, now()), COALESCE(updated_at, created_at, now())
+FROM public.workers
+WHERE ip IS NOT NULL AND ip <> ''
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+-- последовательность назад не откатывается (ID могли быть уже выданы)
+SELECT 1;
@@ -0,0 +1,4 @@
+-- Монеты из 000004_fill_table_coins вставлены с явными ID, последовательность отстала -
+-- продвигаем ее, чтобы CreateCoin не получал уже занятые ID
+
+SELECT setval(pg_get_serial_sequence('public.coins', 'id'), COALESCE((SELECT MAX(id) FROM public.coins), 0) + 1, false);
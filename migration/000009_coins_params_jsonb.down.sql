@@ -0,0 +1,7 @@
+ALTER TABLE IF EXISTS public.coins DROP CONSTRAINT IF EXISTS coins_params_object_check;
+
+ALTER TABLE IF EXISTS public.coins ALTER COLUMN params DROP DEFAULT;
+
+ALTER TABLE IF EXISTS public.coins ALTER COLUMN params TYPE text USING params::text;
+
+ALTER TABLE IF EXISTS public.coins ALTER COLUMN params SET DEFAULT ''::text;
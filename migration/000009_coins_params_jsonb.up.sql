@@ -0,0 +1,11 @@
+-- Параметры монеты: text => jsonb (пустая строка => пустой объект)
+
+ALTER TABLE IF EXISTS public.coins ALTER COLUMN params DROP DEFAULT;
+
+ALTER TABLE IF EXISTS public.coins
+    ALTER COLUMN params TYPE jsonb USING COALESCE(NULLIF(btrim(params), ''), '{}')::jsonb;
+
+ALTER TABLE IF EXISTS public.coins ALTER COLUMN params SET DEFAULT '{}'::jsonb;
+
+ALTER TABLE IF EXISTS public.coins
+    ADD CONSTRAINT coins_params_object_check CHECK (jsonb_typeof(params) = 'object');
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS coins_symbols_check ON public.coins;
+DROP FUNCTION IF EXISTS public.coins_symbols_check();
+
+DROP INDEX IF EXISTS public.coins_lower_symbol2_unique;
+DROP INDEX IF EXISTS public.coins_lower_symbol_unique;
@@ -0,0 +1,48 @@
+-- Символ монеты уникален без учета регистра (поиск идет по lower(symbol)),
+-- альтернативный символ - тоже (пустой не учитывается).
+-- Пересечение символа одной монеты с альтернативным символом другой проверяет триггер coins_symbols_check.
+-- Если в справочнике уже есть такие дубли - миграция упадет, их нужно разрешить вручную.
+
+CREATE UNIQUE INDEX IF NOT EXISTS coins_lower_symbol_unique
+    ON public.coins USING btree
+    (lower(symbol::text) COLLATE pg_catalog."default" ASC NULLS LAST)
+    TABLESPACE pg_default;
+
+CREATE UNIQUE INDEX IF NOT EXISTS coins_lower_symbol2_unique
+    ON public.coins USING btree
+    (lower(symbol2::text) COLLATE pg_catalog."default" ASC NULLS LAST)
+    TABLESPACE pg_default
+    WHERE symbol2 <> '';
+
+-- FUNCTION: public.coins_symbols_check() (символы монеты не должны совпадать с символами других монет
+-- ни в одной из колонок; изменения символов выполняются по очереди под advisory lock до конца транзакции,
+-- поэтому параллельные вставки не проходят проверку одновременно)
+
+CREATE OR REPLACE FUNCTION public.coins_symbols_check()
+    RETURNS trigger
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('public.coins_symbols_check'));
+
+    IF EXISTS (SELECT 1 FROM public.coins
+               WHERE id <> NEW.id
+                 AND (lower(symbol) IN (lower(NEW.symbol), lower(NULLIF(NEW.symbol2, '')))
+                   OR lower(symbol2) IN (lower(NEW.symbol), lower(NULLIF(NEW.symbol2, ''))))) THEN
+        RAISE EXCEPTION 'coin symbol % is already taken', NEW.symbol
+            USING ERRCODE = 'unique_violation';
+    END IF;
+
+    RETURN NEW;
+END;
+$$;
+
+-- Trigger: coins_symbols_check
+
+-- DROP TRIGGER IF EXISTS coins_symbols_check ON public.coins;
+
+CREATE OR REPLACE TRIGGER coins_symbols_check
+    BEFORE INSERT OR UPDATE OF symbol, symbol2
+    ON public.coins
+    FOR EACH ROW
+    EXECUTE FUNCTION public.coins_symbols_check();
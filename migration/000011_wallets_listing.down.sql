@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS public.wallets_created_at_index;
+DROP INDEX IF EXISTS public.wallets_coin_id_average_hashrate_index;
+DROP INDEX IF EXISTS public.wallets_average_hashrate_index;
+DROP INDEX IF EXISTS public.wallets_coin_id_current_hashrate_index;
+DROP INDEX IF EXISTS public.wallets_current_hashrate_index;
+DROP INDEX IF EXISTS public.wallets_name_pattern_index;
+DROP INDEX IF EXISTS public.wallets_name_id_index;
+
+CREATE INDEX IF NOT EXISTS wallets_coin_id_index
+    ON public.wallets USING btree
+    (coin_id ASC NULLS LAST)
+    TABLESPACE pg_default;
+
+DROP INDEX IF EXISTS public.wallets_coin_id_id_index;
+
+ALTER TABLE IF EXISTS public.wallets DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,78 @@
+-- Время создания кошелька (UTC, как и фильтры ListWallets; now() зависит от часового пояса сессии)
+
+ALTER TABLE IF EXISTS public.wallets ADD COLUMN IF NOT EXISTS created_at timestamp(3) without time zone;
+ALTER TABLE IF EXISTS public.wallets ALTER COLUMN created_at SET DEFAULT timezone('utc', now());
+
+-- у существующих кошельков время создания неизвестно - берем время создания первого воркера,
+-- если воркеров нет - время миграции.
+-- workers.created_at пишется в местном времени серверов сервиса - переводим в UTC
+-- (приведение к timestamptz считает его временем в часовом поясе сессии: миграцию запускать с TimeZone серверов, например PGTZ)
+
+UPDATE public.wallets w SET created_at = COALESCE(
+        (SELECT timezone('utc', min(k.created_at)::timestamptz) FROM public.workers k
+         WHERE k.coin_id = w.coin_id AND k.wallet = w.name AND k.reward_method = w.reward_method),
+        timezone('utc', now()))
+    WHERE w.created_at IS NULL;
+
+ALTER TABLE IF EXISTS public.wallets ALTER COLUMN created_at SET NOT NULL;
+
+-- Индексы для ListWallets (keyset пагинация: поле сортировки + id).
+-- Для сортировки без фильтра по монете - индексы по полю сортировки, с фильтром - начинающиеся с coin_id
+
+-- Index: wallets_coin_id_id_index (заменяет wallets_coin_id_index)
+
+CREATE INDEX IF NOT EXISTS wallets_coin_id_id_index
+    ON public.wallets USING btree
+    (coin_id ASC NULLS LAST, id ASC NULLS LAST)
+    TABLESPACE pg_default;
+
+DROP INDEX IF EXISTS public.wallets_coin_id_index;
+
+-- Index: wallets_name_id_index (сортировка по имени, правило сортировки по умолчанию - как в ORDER BY)
+
+CREATE INDEX IF NOT EXISTS wallets_name_id_index
+    ON public.wallets USING btree
+    (name COLLATE pg_catalog."default" ASC NULLS LAST, id ASC NULLS LAST)
+    TABLESPACE pg_default;
+
+-- Index: wallets_name_pattern_index (только поиск по префиксу имени, LIKE 'prefix%'; для ORDER BY name не подходит)
+
+CREATE INDEX IF NOT EXISTS wallets_name_pattern_index
+    ON public.wallets USING btree
+    (name COLLATE pg_catalog."default" varchar_pattern_ops ASC NULLS LAST)
+    TABLESPACE pg_default;
+
+-- Index: wallets_current_hashrate_index
+
+CREATE INDEX IF NOT EXISTS wallets_current_hashrate_index
+    ON public.wallets USING btree
+    (current_hashrate ASC NULLS LAST, id ASC NULLS LAST)
+    TABLESPACE pg_default;
+
+-- Index: wallets_coin_id_current_hashrate_index
+
+CREATE INDEX IF NOT EXISTS wallets_coin_id_current_hashrate_index
+    ON public.wallets USING btree
+    (coin_id ASC NULLS LAST, current_hashrate ASC NULLS LAST, id ASC NULLS LAST)
+    TABLESPACE pg_default;
+
+-- Index: wallets_average_hashrate_index
+
+CREATE INDEX IF NOT EXISTS wallets_average_hashrate_index
+    ON public.wallets USING btree
+    (average_hashrate ASC NULLS LAST, id ASC NULLS LAST)
+    TABLESPACE pg_default;
+
+-- Index: wallets_coin_id_average_hashrate_index
+
+CREATE INDEX IF NOT EXISTS wallets_coin_id_average_hashrate_index
+    ON public.wallets USING btree
+    (coin_id ASC NULLS LAST, average_hashrate ASC NULLS LAST, id ASC NULLS LAST)
+    TABLESPACE pg_default;
+
+-- Index: wallets_created_at_index
+
+CREATE INDEX IF NOT EXISTS wallets_created_at_index
+    ON public.wallets USING btree
+    (created_at ASC NULLS LAST, id ASC NULLS LAST)
+    TABLESPACE pg_default;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS public.workers_wallet_coin_id_reward_method_index;
@@ -0,0 +1,6 @@
+-- Index: workers_wallet_coin_id_reward_method_index (ListWorkersByWallet, keyset пагинация по id)
+
+CREATE INDEX IF NOT EXISTS workers_wallet_coin_id_reward_method_index
+    ON public.workers USING btree
+    (wallet COLLATE pg_catalog."default" ASC NULLS LAST, coin_id ASC NULLS LAST, reward_method COLLATE pg_catalog."default" ASC NULLS LAST, id ASC NULLS LAST)
+    TABLESPACE pg_default;
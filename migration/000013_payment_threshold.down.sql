@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS public.payment_threshold_history;
+
+ALTER TABLE IF EXISTS public.coins DROP COLUMN IF EXISTS max_payment_threshold;
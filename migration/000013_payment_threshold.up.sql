@@ -0,0 +1,33 @@
+-- Максимальный порог выплаты кошелька для монеты (NULL - без ограничения)
+
+ALTER TABLE IF EXISTS public.coins ADD COLUMN IF NOT EXISTS max_payment_threshold numeric(20,6);
+
+-- Table: public.payment_threshold_history (журнал изменений порога выплаты кошельков)
+
+-- DROP TABLE IF EXISTS public.payment_threshold_history;
+
+CREATE TABLE IF NOT EXISTS public.payment_threshold_history
+(
+    id BIGSERIAL PRIMARY KEY,
+    wallet_id bigint NOT NULL,
+    old_value numeric(20,6) NOT NULL,
+    new_value numeric(20,6) NOT NULL,
+    service character varying(64) COLLATE pg_catalog."default" NOT NULL DEFAULT ''::character varying,
+    actor character varying(255) COLLATE pg_catalog."default" NOT NULL DEFAULT ''::character varying,
+    changed_at timestamp(3) without time zone NOT NULL,
+    CONSTRAINT payment_threshold_history_wallet_id_foreign FOREIGN KEY (wallet_id)
+        REFERENCES public.wallets (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+)
+
+    TABLESPACE pg_default;
+
+-- Index: payment_threshold_history_wallet_id_changed_at_index
+
+-- DROP INDEX IF EXISTS public.payment_threshold_history_wallet_id_changed_at_index;
+
+CREATE INDEX IF NOT EXISTS payment_threshold_history_wallet_id_changed_at_index
+    ON public.payment_threshold_history USING btree
+    (wallet_id ASC NULLS LAST, changed_at DESC NULLS LAST, id DESC NULLS LAST)
+    TABLESPACE pg_default;
@@ -0,0 +1,36 @@
+-- Table: public.settings_changes (изменения настроек кошелька, ожидающие подтверждения майнером)
+-- заменяет неиспользуемую колонку workers.threshold_change
+
+-- DROP TABLE IF EXISTS public.settings_changes;
+
+CREATE TABLE IF NOT EXISTS public.settings_changes
+(
+    id BIGSERIAL PRIMARY KEY,
+    wallet_id bigint NOT NULL,
+    kind character varying(32) COLLATE pg_catalog."default" NOT NULL,
+    value text COLLATE pg_catalog."default" NOT NULL,
+    token_hash character(64) COLLATE pg_catalog."default" NOT NULL,
+    service character varying(64) COLLATE pg_catalog."default" NOT NULL DEFAULT ''::character varying,
+    actor character varying(255) COLLATE pg_catalog."default" NOT NULL DEFAULT ''::character varying,
+    created_at timestamp(3) without time zone NOT NULL,
+    expires_at timestamp(3) without time zone NOT NULL,
+    confirmed_at timestamp(3) without time zone,
+    cancelled_at timestamp(3) without time zone,
+    CONSTRAINT settings_changes_token_hash_unique UNIQUE (token_hash),
+    CONSTRAINT settings_changes_wallet_id_foreign FOREIGN KEY (wallet_id)
+        REFERENCES public.wallets (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+)
+
+    TABLESPACE pg_default;
+
+-- Index: settings_changes_wallet_id_pending_index (ожидающие подтверждения изменения кошелька)
+
+-- DROP INDEX IF EXISTS public.settings_changes_wallet_id_pending_index;
+
+CREATE INDEX IF NOT EXISTS settings_changes_wallet_id_pending_index
+    ON public.settings_changes USING btree
+    (wallet_id ASC NULLS LAST, kind COLLATE pg_catalog."default" ASC NULLS LAST)
+    TABLESPACE pg_default
+    WHERE confirmed_at IS NULL AND cancelled_at IS NULL;
@@ -0,0 +1,17 @@
+-- удаленные записи окончательно удаляются (иначе не восстановить ограничения уникальности)
+
+DELETE FROM public.workers WHERE deleted_at IS NOT NULL;
+DELETE FROM public.wallets WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS public.workers_workerfull_coin_id_reward_method_unique;
+ALTER TABLE public.workers
+    ADD CONSTRAINT workers_workerfull_coin_id_reward_method_unique UNIQUE (workerfull, coin_id, reward_method);
+
+DROP INDEX IF EXISTS public.wallets_name_coin_id_reward_method_unique;
+ALTER TABLE public.wallets
+    ADD CONSTRAINT wallets_name_coin_id_reward_method_unique UNIQUE (name, coin_id, reward_method);
+
+ALTER TABLE IF EXISTS public.workers DROP CONSTRAINT IF EXISTS workers_merged_into_foreign;
+ALTER TABLE IF EXISTS public.workers DROP COLUMN IF EXISTS merged_into;
+ALTER TABLE IF EXISTS public.workers DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE IF EXISTS public.wallets DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,32 @@
+-- Мягкое удаление кошельков и воркеров (deleted_at), объединение воркеров (merged_into)
+-- удаленные записи не участвуют в поиске по имени, уникальность имени - только среди неудаленных
+
+ALTER TABLE IF EXISTS public.wallets ADD COLUMN IF NOT EXISTS deleted_at timestamp(3) without time zone;
+ALTER TABLE IF EXISTS public.workers ADD COLUMN IF NOT EXISTS deleted_at timestamp(3) without time zone;
+ALTER TABLE IF EXISTS public.workers ADD COLUMN IF NOT EXISTS merged_into bigint;
+
+ALTER TABLE public.workers
+    ADD CONSTRAINT workers_merged_into_foreign FOREIGN KEY (merged_into)
+        REFERENCES public.workers (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE SET NULL;
+
+-- Index: wallets_name_coin_id_reward_method_unique (вместо ограничения из 000005)
+
+ALTER TABLE public.wallets DROP CONSTRAINT IF EXISTS wallets_name_coin_id_reward_method_unique;
+
+CREATE UNIQUE INDEX IF NOT EXISTS wallets_name_coin_id_reward_method_unique
+    ON public.wallets USING btree
+    (name COLLATE pg_catalog."default" ASC NULLS LAST, coin_id ASC NULLS LAST, reward_method COLLATE pg_catalog."default" ASC NULLS LAST)
+    TABLESPACE pg_default
+    WHERE deleted_at IS NULL;
+
+-- Index: workers_workerfull_coin_id_reward_method_unique (вместо ограничения из 000005)
+
+ALTER TABLE public.workers DROP CONSTRAINT IF EXISTS workers_workerfull_coin_id_reward_method_unique;
+
+CREATE UNIQUE INDEX IF NOT EXISTS workers_workerfull_coin_id_reward_method_unique
+    ON public.workers USING btree
+    (workerfull COLLATE pg_catalog."default" ASC NULLS LAST, coin_id ASC NULLS LAST, reward_method COLLATE pg_catalog."default" ASC NULLS LAST)
+    TABLESPACE pg_default
+    WHERE deleted_at IS NULL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS public.share_keys;
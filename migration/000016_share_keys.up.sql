@@ -0,0 +1,22 @@
+-- Table: public.share_keys (ключи принятых шар для поиска повторов, хранятся в пределах окна дедупликации)
+-- ключ - UUID шары или nonce воркера монеты (см. internal/dedup)
+
+-- DROP TABLE IF EXISTS public.share_keys;
+
+CREATE TABLE IF NOT EXISTS public.share_keys
+(
+    key text COLLATE pg_catalog."default" NOT NULL,
+    seen_at timestamp(3) without time zone NOT NULL,
+    CONSTRAINT share_keys_pkey PRIMARY KEY (key)
+)
+
+    TABLESPACE pg_default;
+
+-- Index: share_keys_seen_at_index (удаление устаревших ключей)
+
+-- DROP INDEX IF EXISTS public.share_keys_seen_at_index;
+
+CREATE INDEX IF NOT EXISTS share_keys_seen_at_index
+    ON public.share_keys USING btree
+    (seen_at ASC NULLS LAST)
+    TABLESPACE pg_default;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS public.workers_offline_check_index;
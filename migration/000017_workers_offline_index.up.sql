@@ -0,0 +1,10 @@
+-- Index: workers_offline_check_index (поиск молчащих воркеров монеты, см. WorkerStatsUpdater.MarkWorkersOffline;
+-- частичный - только подключенные неудаленные воркеры, выражение совпадает с условием запроса)
+
+-- DROP INDEX IF EXISTS public.workers_offline_check_index;
+
+CREATE INDEX IF NOT EXISTS workers_offline_check_index
+    ON public.workers USING btree
+    (coin_id ASC NULLS LAST, (COALESCE(last_share_date, updated_at, created_at)) ASC NULLS LAST)
+    TABLESPACE pg_default
+    WHERE is_connect AND deleted_at IS NULL;
@@ -0,0 +1,15 @@
+ALTER TABLE IF EXISTS public.wallets ADD COLUMN IF NOT EXISTS is_solo boolean NOT NULL DEFAULT false;
+ALTER TABLE IF EXISTS public.workers ADD COLUMN IF NOT EXISTS is_solo boolean NOT NULL DEFAULT false;
+
+UPDATE public.wallets SET is_solo = true WHERE reward_method = 'SOLO';
+UPDATE public.workers SET is_solo = true WHERE reward_method = 'SOLO';
+
+CREATE INDEX IF NOT EXISTS wallets_is_solo_index
+    ON public.wallets USING btree
+    (is_solo ASC NULLS LAST)
+    TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS workers_is_solo_index
+    ON public.workers USING btree
+    (is_solo ASC NULLS LAST)
+    TABLESPACE pg_default;
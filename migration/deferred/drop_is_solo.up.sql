@@ -0,0 +1,10 @@
+-- Окончательное удаление устаревшего флага is_solo (соло режим определяется по reward_method).
+-- Миграция отложена: перенести в migration/ со следующим порядковым номером после того,
+-- как счетчик "Deprecated is_solo requests" в логах сервиса перестанет расти
+-- Одновременно убрать is_solo из proto/miners.proto и из INSERT в postgres репозиториях.
+
+DROP INDEX IF EXISTS public.wallets_is_solo_index;
+DROP INDEX IF EXISTS public.workers_is_solo_index;
+
+ALTER TABLE IF EXISTS public.wallets DROP COLUMN IF EXISTS is_solo;
+ALTER TABLE IF EXISTS public.workers DROP COLUMN IF EXISTS is_solo;
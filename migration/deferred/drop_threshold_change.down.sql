@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS public.workers ADD COLUMN IF NOT EXISTS threshold_change text COLLATE pg_catalog."default" NOT NULL DEFAULT ''::text;
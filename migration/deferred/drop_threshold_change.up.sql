@@ -0,0 +1,5 @@
+-- Удаление неиспользуемой колонки workers.threshold_change (изменения настроек хранятся в settings_changes).
+-- Миграция отложена: перенести в migration/ со следующим порядковым номером после того,
+-- как будет проверено, что внешние сервисы не читают колонку напрямую из БД
+
+ALTER TABLE IF EXISTS public.workers DROP COLUMN IF EXISTS threshold_change;
@@ -0,0 +1,50 @@
+package jwt
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetAdminInterceptor - gRPC серверный интерсептор, разрешающий методы methods (полные имена вида /package.Service/Method)
+// только сервисам из adminServices. Должен стоять в цепочке после GetValidateInterceptor (данные токена берутся из контекста)
+func (s *ServiceSymmetric) GetAdminInterceptor(adminServices []string, methods ...string) grpc.UnaryServerInterceptor {
+	admins := make(map[string]struct{}, len(adminServices))
+	for _, name := range adminServices {
+		admins[name] = struct{}{}
+	}
+	restricted := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		restricted[m] = struct{}{}
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, ok := restricted[info.FullMethod]; ok {
+			if err := checkAdmin(ctx, admins, info.FullMethod); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// checkAdmin сервис из токена должен быть в списке сервисов, которым разрешен метод method
+func checkAdmin(ctx context.Context, admins map[string]struct{}, method string) error {
+	claims, ok := ctx.Value("claims").(*ClaimsSymmetric)
+	if !ok || claims == nil {
+		return status.Error(codes.Unauthenticated, "missing token claims")
+	}
+	if _, ok := admins[claims.ServiceName]; !ok {
+		return status.Errorf(codes.PermissionDenied, "service %s is not allowed to call %s", claims.ServiceName, method)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package jwt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminInterceptor(t *testing.T) {
+	s := NewJWTServiceSymmetric("minersprocessor", []string{"normalizer", "adminpanel"}, "jwtsecret", 60)
+	interceptor := s.GetAdminInterceptor([]string{"adminpanel"}, "/grpc.MinersService/CreateCoin")
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(service string, method string) error {
+		ctx := context.Background()
+		if service != "" {
+			ctx = context.WithValue(ctx, "claims", &ClaimsSymmetric{ServiceName: service})
+		}
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	require.NoError(t, call("adminpanel", "/grpc.MinersService/CreateCoin"))
+	require.NoError(t, call("normalizer", "/grpc.MinersService/ListCoins"))
+	require.Equal(t, codes.PermissionDenied, status.Code(call("normalizer", "/grpc.MinersService/CreateCoin")))
+	require.Equal(t, codes.Unauthenticated, status.Code(call("", "/grpc.MinersService/CreateCoin")))
+}
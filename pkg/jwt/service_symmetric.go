@@ -128,41 +128,79 @@ func (s *ServiceSymmetric) GetValidateInterceptor() grpc.UnaryServerInterceptor
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// Извлечение метаданных
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, fmt.Errorf("missing metadata")
-		}
-
-		// Проверка заголовка авторизации
-		authHeader, exists := md["authorization"]
-		if !exists || len(authHeader) == 0 {
-			return nil, fmt.Errorf("missing authorization token")
+		ctx, err := s.validateContext(ctx)
+		if err != nil {
+			return nil, err
 		}
 
-		// Извлечение токена
-		token := strings.TrimSpace(authHeader[0])
+		// Продолжение выполнения запроса
+		return handler(ctx, req)
+	}
+}
 
-		// Валидация токена
-		claims, err := s.GetClaims(token)
+// GetValidateStreamInterceptor - gRPC серверный интерсептор для проверки JWT в потоковых методах
+// (токен проверяется один раз при открытии потока)
+func (s *ServiceSymmetric) GetValidateStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := s.validateContext(ss.Context())
 		if err != nil {
-			return nil, fmt.Errorf("invalid token: %v", err)
-		}
-		if !s.IsServiceValid(claims) {
-			return nil, fmt.Errorf("invalid service: %v", err)
+			return err
 		}
 
-		// Проверяем, истек ли срок действия
-		if claims.ExpiresAt != nil && claims.ExpiresAt.Time.Before(time.Now()) {
-			return nil, fmt.Errorf("token has expired")
-		}
+		// Продолжение выполнения запроса
+		return handler(srv, &claimsServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		// Добавление данных из токена в контекст
-		ctx = context.WithValue(ctx, "claims", claims)
+// validateContext проверка JWT из метаданных входящего запроса,
+// возвращает контекст с добавленными данными из токена
+func (s *ServiceSymmetric) validateContext(ctx context.Context) (context.Context, error) {
+	// Извлечение метаданных
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("missing metadata")
+	}
 
-		// Продолжение выполнения запроса
-		return handler(ctx, req)
+	// Проверка заголовка авторизации
+	authHeader, exists := md["authorization"]
+	if !exists || len(authHeader) == 0 {
+		return nil, fmt.Errorf("missing authorization token")
 	}
+
+	// Извлечение токена
+	token := strings.TrimSpace(authHeader[0])
+
+	// Валидация токена
+	claims, err := s.GetClaims(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %v", err)
+	}
+	if !s.IsServiceValid(claims) {
+		return nil, fmt.Errorf("invalid service: %v", err)
+	}
+
+	// Проверяем, истек ли срок действия
+	if claims.ExpiresAt != nil && claims.ExpiresAt.Time.Before(time.Now()) {
+		return nil, fmt.Errorf("token has expired")
+	}
+
+	// Добавление данных из токена в контекст
+	return context.WithValue(ctx, "claims", claims), nil
+}
+
+// claimsServerStream серверный поток с подмененным контекстом (содержит данные из токена)
+type claimsServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *claimsServerStream) Context() context.Context {
+	return s.ctx
 }
 
 // GetClientInterceptor Unary Interceptor для добавления JWT-токена
@@ -190,3 +228,28 @@ func (s *ServiceSymmetric) GetClientInterceptor() grpc.UnaryClientInterceptor {
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+// GetClientStreamInterceptor Stream Interceptor для добавления JWT-токена в потоковые методы
+func (s *ServiceSymmetric) GetClientStreamInterceptor() grpc.StreamClientInterceptor {
+
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+
+		token, err := s.GetActualToken()
+		if err != nil {
+			return nil, err
+		}
+
+		// Добавляем токен в метаданные
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", token)
+
+		// Открываем поток
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
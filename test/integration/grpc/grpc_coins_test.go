@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+func TestGRPCCoins(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	// монеты из миграций
+	alph, err := client.GetCoin(ctx, &proto.GetCoinRequest{Id: 4})
+	require.NoError(t, err)
+	require.Equal(t, "ALPH", alph.Coin.Symbol)
+	require.Equal(t, "0.000000", alph.Coin.MinWithdraw.GetValue())
+
+	all, err := client.ListCoins(ctx, &proto.ListCoinsRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, all.Coins)
+
+	// создание (ID не пересекается с монетами из миграций)
+	created, err := client.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{
+		Symbol: "TESTCOIN", Name: "Test coin", Algo: "sha256", MinWithdraw: &proto.Decimal{Value: "0.25"}, CoinsInBlock: &proto.Decimal{Value: "3.125"},
+	}})
+	require.NoError(t, err)
+	for _, c := range all.Coins {
+		require.NotEqual(t, c.Id, created.Id)
+	}
+
+	_, err = client.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "TESTCOIN"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// новой монете доступны все методы начисления вознаграждения
+	methods, err := client.ListRewardMethods(ctx, &proto.ListRewardMethodsRequest{CoinId: created.Id})
+	require.NoError(t, err)
+	require.Len(t, methods.Methods, 6)
+
+	// изменение
+	updated, err := client.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: &proto.Coin{
+		Id: created.Id, Symbol: "TESTCOIN", Name: "Test coin 2", MinWithdraw: &proto.Decimal{Value: "0.5"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, "Test coin 2", updated.Coin.Name)
+	require.Equal(t, "0.500000", updated.Coin.MinWithdraw.GetValue())
+	require.False(t, updated.Coin.IsActive)
+
+	_, err = client.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: &proto.Coin{Id: created.Id, Symbol: "ALPH"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = client.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: &proto.Coin{Id: 1 << 40, Symbol: "NONAME"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// активация
+	_, err = client.SetCoinActive(ctx, &proto.SetCoinActiveRequest{Id: created.Id, IsActive: true})
+	require.NoError(t, err)
+	active, err := client.ListCoins(ctx, &proto.ListCoinsRequest{ActiveOnly: true})
+	require.NoError(t, err)
+	found := false
+	for _, c := range active.Coins {
+		require.True(t, c.IsActive)
+		found = found || c.Id == created.Id
+	}
+	require.True(t, found)
+
+	_, err = client.GetCoin(ctx, &proto.GetCoinRequest{Id: 1 << 40})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// параметры из миграции (text => jsonb)
+	params, err := client.GetCoinParams(ctx, &proto.GetCoinParamsRequest{CoinId: 4})
+	require.NoError(t, err)
+	require.Equal(t, "2.0654726609957113", params.Params.CurrencyRates["USD"].Value)
+	require.Equal(t, "0.0462121797100464", params.Params.CurrentRewardPerGigahashMinerstat.Value)
+
+	// изменение отдельных параметров (остальные сохраняются)
+	patched, err := client.PatchCoinParams(ctx, &proto.PatchCoinParamsRequest{
+		CoinId:                   4,
+		CurrencyRates:            map[string]*proto.Decimal{"EUR": {Value: "1.9"}},
+		CurrentRewardPerGigahash: &proto.Decimal{Value: "0.125"},
+	})
+	require.NoError(t, err)
+	require.Len(t, patched.Params.CurrencyRates, 2)
+	require.Equal(t, "1.9", patched.Params.CurrencyRates["EUR"].Value)
+	require.Equal(t, "0.125", patched.Params.CurrentRewardPerGigahash.Value)
+	require.Equal(t, "0.0462121797100464", patched.Params.CurrentRewardPerGigahashMinerstat.Value)
+
+	// у созданной без параметров монеты курсы - пустой объект, слияние работает
+	patched, err = client.PatchCoinParams(ctx, &proto.PatchCoinParamsRequest{
+		CoinId:        created.Id,
+		CurrencyRates: map[string]*proto.Decimal{"USD": {Value: "10"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, patched.Params.CurrencyRates, 1)
+	require.Equal(t, "10", patched.Params.CurrencyRates["USD"].Value)
+
+	// поиск по символу без учета регистра и по альтернативному символу
+	byName, err := client.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "alph"})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), byName.Id)
+
+	_, err = client.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: &proto.Coin{Id: created.Id, Symbol: "TESTCOIN", Symbol2: "TST"}})
+	require.NoError(t, err)
+	byName, err = client.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "tst"})
+	require.NoError(t, err)
+	require.Equal(t, created.Id, byName.Id)
+
+	resolved, err := client.ResolveMiners(ctx, &proto.ResolveMinersRequest{Miners: []*proto.MinerIdentity{
+		{Coin: "Tst", Wallet: "w", Workerfull: "w.rig", ServerId: "TST-1", RewardMethod: "PPLNS"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, created.Id, resolved.Miners[0].CoinId)
+
+	_, err = client.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "testcoin"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = client.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: &proto.Coin{Symbol: "OTHER", Symbol2: "alph"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// параллельное создание монет, у которых символ одной совпадает с альтернативным символом другой
+	coins := []*proto.Coin{{Symbol: "RACE"}, {Symbol: "RACE2", Symbol2: "race"}}
+	errs := make([]error, len(coins))
+	var wg sync.WaitGroup
+	for i, c := range coins {
+		wg.Add(1)
+		go func(i int, c *proto.Coin) {
+			defer wg.Done()
+			_, errs[i] = client.CreateCoin(ctx, &proto.CreateCoinRequest{Coin: c})
+		}(i, c)
+	}
+	wg.Wait()
+	require.ElementsMatch(t, []codes.Code{codes.OK, codes.AlreadyExists}, []codes.Code{status.Code(errs[0]), status.Code(errs[1])})
+
+	// выключенная монета находится только по запросу
+	_, err = client.SetCoinActive(ctx, &proto.SetCoinActiveRequest{Id: created.Id, IsActive: false})
+	require.NoError(t, err)
+	_, err = client.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "TESTCOIN"})
+	require.Equal(t, codes.Internal, status.Code(err))
+	byName, err = client.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "TESTCOIN", IncludeInactive: true})
+	require.NoError(t, err)
+	require.Equal(t, created.Id, byName.Id)
+}
@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+// TestGRPCConcurrentCreate одновременная регистрация одного и того же майнера/воркера
+// (как при одновременном подключении к нескольким пул-серверам) должна давать одну запись
+func TestGRPCConcurrentCreate(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	const goroutines = 50
+
+	var wg sync.WaitGroup
+	walletIDs := make([]int64, goroutines)
+	workerIDs := make([]int64, goroutines)
+	errs := make([]error, goroutines)
+
+	start := make(chan struct{})
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+
+			res, err := client.CreateWallet(ctx, &proto.CreateWalletRequest{
+				CoinId:       4,
+				Name:         "concurrent",
+				RewardMethod: "PPLNS",
+			})
+			if err != nil {
+				errs[i] = fmt.Errorf("CreateWallet: %w", err)
+				return
+			}
+			walletIDs[i] = res.Id
+
+			resW, err := client.CreateWorker(ctx, &proto.CreateWorkerRequest{
+				CoinId:       4,
+				Workerfull:   "concurrent.rig",
+				Wallet:       "concurrent",
+				Worker:       "rig",
+				ServerId:     fmt.Sprintf("SERV-%d", i%4),
+				Ip:           "127.0.0.1",
+				RewardMethod: "PPLNS",
+			})
+			if err != nil {
+				errs[i] = fmt.Errorf("CreateWorker: %w", err)
+				return
+			}
+			workerIDs[i] = resW.Id
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	for i := 0; i < goroutines; i++ {
+		require.NoError(t, errs[i])
+		require.NotZero(t, walletIDs[i])
+		require.Equal(t, walletIDs[0], walletIDs[i])
+		require.NotZero(t, workerIDs[i])
+		require.Equal(t, workerIDs[0], workerIDs[i])
+	}
+
+	resWallet, err := client.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{
+		Wallet:       "concurrent",
+		CoinId:       4,
+		RewardMethod: "PPLNS",
+	})
+	require.NoError(t, err)
+	require.Equal(t, walletIDs[0], resWallet.Id)
+
+	resWorker, err := client.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{
+		Workerfull:   "concurrent.rig",
+		CoinId:       4,
+		RewardMethod: "PPLNS",
+	})
+	require.NoError(t, err)
+	require.Equal(t, workerIDs[0], resWorker.Id)
+}
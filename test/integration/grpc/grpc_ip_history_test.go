@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+func TestGRPCWorkerIPHistory(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	req := &proto.CreateWorkerRequest{
+		CoinId:       4,
+		Workerfull:   "iphistory.rig",
+		Wallet:       "iphistory",
+		Worker:       "rig",
+		ServerId:     "ALPH-1",
+		Ip:           "10.0.0.1",
+		RewardMethod: "PPLNS",
+	}
+	created, err := client.CreateWorker(ctx, req)
+	require.NoError(t, err)
+
+	// переподключение через CreateWorker
+	req.Ip = "10.0.0.2"
+	again, err := client.CreateWorker(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, created.Id, again.Id)
+
+	// переподключение через ResolveMiners (к другому пул-серверу)
+	resolved, err := client.ResolveMiners(ctx, &proto.ResolveMinersRequest{Miners: []*proto.MinerIdentity{
+		{Coin: "ALPH", Wallet: "iphistory", Workerfull: "iphistory.rig", ServerId: "ALPH-2", Ip: "10.0.0.3", RewardMethod: "PPLNS"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, created.Id, resolved.Miners[0].WorkerId)
+
+	workers, err := client.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{Wallet: "iphistory", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Len(t, workers.Workers, 1)
+	require.Equal(t, "ALPH-2", workers.Workers[0].ServerId)
+	require.Equal(t, "10.0.0.3", workers.Workers[0].Ip)
+
+	res, err := client.GetWorkerIPHistory(ctx, &proto.GetWorkerIPHistoryRequest{WorkerId: created.Id})
+	require.NoError(t, err)
+	require.Len(t, res.History, 3)
+	require.Equal(t, "10.0.0.3", res.History[0].Ip)
+	for _, h := range res.History {
+		require.LessOrEqual(t, h.FirstSeen, h.LastSeen)
+	}
+
+	// воркер без истории
+	noIP, err := client.CreateWorker(ctx, &proto.CreateWorkerRequest{
+		CoinId: 4, Workerfull: "iphistory.noip", Wallet: "iphistory", Worker: "noip", ServerId: "ALPH-1", RewardMethod: "PPLNS",
+	})
+	require.NoError(t, err)
+	res, err = client.GetWorkerIPHistory(ctx, &proto.GetWorkerIPHistoryRequest{WorkerId: noIP.Id})
+	require.NoError(t, err)
+	require.Len(t, res.History, 0)
+
+	_, err = client.GetWorkerIPHistory(ctx, &proto.GetWorkerIPHistoryRequest{WorkerId: 1 << 40})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
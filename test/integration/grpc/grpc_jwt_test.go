@@ -71,7 +71,7 @@ func TestJWTTest(t *testing.T) {
 	go func() {
 		interceptor := jwt.GetValidateInterceptor()
 		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
-		minersServer, err := pb.NewGRPCServer(pool)
+		minersServer, err := pb.NewGRPCServer(postgresDependencies(pool))
 		require.NoError(t, err)
 		proto.RegisterMinersServiceServer(grpcServer, minersServer)
 		close(serverReady) // Уведомляем, что сервер готов
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+func TestGRPCListWallets(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	start := time.Now().Add(-time.Minute).UnixMilli()
+	for _, name := range []string{"list_b", "list_a", "list_c", "list%d", "other"} {
+		_, err := client.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: name, RewardMethod: "PPLNS"})
+		require.NoError(t, err)
+	}
+	_, err = client.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "list_s", RewardMethod: "SOLO"})
+	require.NoError(t, err)
+
+	// Постраничный вывод по имени с отбором по префиксу (символ "_" не является шаблоном)
+	var names []string
+	req := &proto.ListWalletsRequest{
+		CoinId:       4,
+		RewardMethod: "PPLNS",
+		NamePrefix:   "list_",
+		Sort:         proto.WalletSortField_WALLET_SORT_NAME,
+		Desc:         true,
+		PageSize:     2,
+		CreatedFrom:  start,
+	}
+	for {
+		res, err := client.ListWallets(ctx, req)
+		require.NoError(t, err)
+		for _, w := range res.Wallets {
+			require.NotZero(t, w.CreatedAt)
+			names = append(names, w.Name)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	require.Equal(t, []string{"list_c", "list_b", "list_a"}, names)
+
+	// Отбор по хешрейту и сортировка по времени создания
+	minHashrate := int64(1)
+	res, err := client.ListWallets(ctx, &proto.ListWalletsRequest{MinHashrate: &minHashrate, Sort: proto.WalletSortField_WALLET_SORT_CREATED_AT})
+	require.NoError(t, err)
+	require.Empty(t, res.Wallets)
+
+	res, err = client.ListWallets(ctx, &proto.ListWalletsRequest{Sort: proto.WalletSortField_WALLET_SORT_CURRENT_HASHRATE, PageSize: 5})
+	require.NoError(t, err)
+	require.Len(t, res.Wallets, 5)
+	res, err = client.ListWallets(ctx, &proto.ListWalletsRequest{Sort: proto.WalletSortField_WALLET_SORT_CURRENT_HASHRATE, PageSize: 5, PageToken: res.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, res.Wallets, 1)
+	require.Empty(t, res.NextPageToken)
+
+	_, err = client.ListWallets(ctx, &proto.ListWalletsRequest{Sort: proto.WalletSortField_WALLET_SORT_NAME, PageToken: req.PageToken})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
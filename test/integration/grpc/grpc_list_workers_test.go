@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+func TestGRPCListWorkersByWallet(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	for _, worker := range []string{"rig1", "rig2", "rig3"} {
+		_, err := client.CreateWorker(ctx, &proto.CreateWorkerRequest{
+			CoinId: 4, Workerfull: "listworkers." + worker, Wallet: "listworkers", Worker: worker,
+			ServerId: "ALPH-1", Ip: "10.0.0.1", RewardMethod: "PPLNS",
+		})
+		require.NoError(t, err)
+	}
+
+	var workers []*proto.WorkerInfo
+	req := &proto.ListWorkersByWalletRequest{Wallet: "listworkers", CoinId: 4, RewardMethod: "PPLNS", PageSize: 2}
+	for {
+		res, err := client.ListWorkersByWallet(ctx, req)
+		require.NoError(t, err)
+		workers = append(workers, res.Workers...)
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	require.Len(t, workers, 3)
+	for i, w := range workers {
+		require.Equal(t, []string{"rig1", "rig2", "rig3"}[i], w.Worker)
+		require.Equal(t, "10.0.0.1", w.Ip)
+		require.False(t, w.IsConnect)
+		require.Zero(t, w.LastShareDate)
+		require.Equal(t, "0.0000000000", w.CurrentDiff.GetValue())
+	}
+
+	res, err := client.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{
+		Wallet: "listworkers", CoinId: 4, RewardMethod: "PPLNS", Status: proto.WorkerStatusFilter_WORKER_STATUS_ONLINE,
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Workers)
+
+	_, err = client.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{Wallet: "listworkers", CoinId: 4})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
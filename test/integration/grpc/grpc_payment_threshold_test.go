@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+func TestGRPCPaymentThreshold(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	// ограничения монеты
+	coin, err := client.GetCoin(ctx, &proto.GetCoinRequest{Id: 4})
+	require.NoError(t, err)
+	coin.Coin.MinWithdraw = &proto.Decimal{Value: "0.5"}
+	coin.Coin.MaxPaymentThreshold = &proto.Decimal{Value: "1000"}
+	_, err = client.UpdateCoin(ctx, &proto.UpdateCoinRequest{Coin: coin.Coin})
+	require.NoError(t, err)
+
+	wallet, err := client.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "threshold", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+
+	got, err := client.GetPaymentThreshold(ctx, &proto.GetPaymentThresholdRequest{WalletId: wallet.Id})
+	require.NoError(t, err)
+	require.Equal(t, "0.000000", got.PaymentThreshold.GetValue())
+	require.Equal(t, "0.500000", got.EffectivePaymentThreshold.GetValue())
+	require.Equal(t, "1000.000000", got.MaxPaymentThreshold.GetValue())
+
+	set, err := client.SetPaymentThreshold(ctx, &proto.SetPaymentThresholdRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "12.5"}, Actor: "user1"})
+	require.NoError(t, err)
+	require.True(t, set.Changed)
+	require.Equal(t, "12.500000", set.PaymentThreshold.GetValue())
+
+	set, err = client.SetPaymentThreshold(ctx, &proto.SetPaymentThresholdRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "12.50"}})
+	require.NoError(t, err)
+	require.False(t, set.Changed)
+
+	_, err = client.SetPaymentThreshold(ctx, &proto.SetPaymentThresholdRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "0.1"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.SetPaymentThreshold(ctx, &proto.SetPaymentThresholdRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "abc"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// больше 14 цифр целой части не помещается в numeric(20,6)
+	_, err = client.SetPaymentThreshold(ctx, &proto.SetPaymentThresholdRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "100000000000000"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	w, err := client.GetWallet(ctx, &proto.GetWalletRequest{Id: wallet.Id})
+	require.NoError(t, err)
+	require.Equal(t, "12.500000", w.Wallet.PaymentThreshold.GetValue())
+
+	changes, err := client.ListPaymentThresholdChanges(ctx, &proto.ListPaymentThresholdChangesRequest{WalletId: wallet.Id})
+	require.NoError(t, err)
+	require.Len(t, changes.Changes, 1)
+	require.Equal(t, "0.000000", changes.Changes[0].OldValue.GetValue())
+	require.Equal(t, "12.500000", changes.Changes[0].NewValue.GetValue())
+	require.Equal(t, "user1", changes.Changes[0].Actor)
+}
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+func TestGRPCResolveMiners(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	// воркер, созданный ранее обычным способом
+	resW, err := client.CreateWorker(ctx, &proto.CreateWorkerRequest{
+		CoinId:       4,
+		Workerfull:   "wallet.rig1",
+		Wallet:       "wallet",
+		Worker:       "rig1",
+		ServerId:     "SERV",
+		RewardMethod: "PPLNS",
+	})
+	require.NoError(t, err)
+
+	req := &proto.ResolveMinersRequest{
+		Miners: []*proto.MinerIdentity{
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig1", ServerId: "SERV", Ip: "10.0.0.1", RewardMethod: "PPLNS"},
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig2", ServerId: "SERV", Ip: "10.0.0.2", RewardMethod: "PPLNS"},
+			{Coin: "KAS", Wallet: "kaswallet", Workerfull: "kaswallet.rig1", ServerId: "SERV", RewardMethod: "SOLO"},
+			{Coin: "NONAME", Wallet: "wallet", Workerfull: "wallet.rig1", ServerId: "SERV", RewardMethod: "PPLNS"},
+			{Coin: "ALPH", Wallet: "wallet", Workerfull: "wallet.rig1", ServerId: "SERV", Ip: "10.0.0.1", RewardMethod: "PPLNS"},
+		},
+	}
+	res, err := client.ResolveMiners(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Miners, 5)
+
+	require.Equal(t, int64(4), res.Miners[0].CoinId)
+	require.Equal(t, resW.Id, res.Miners[0].WorkerId)
+	require.Equal(t, res.Miners[0].WalletId, res.Miners[1].WalletId)
+	require.NotEqual(t, res.Miners[0].WorkerId, res.Miners[1].WorkerId)
+	require.Equal(t, int64(8), res.Miners[2].CoinId)
+	require.NotZero(t, res.Miners[2].WalletId)
+	require.Equal(t, int64(0), res.Miners[3].CoinId)
+	require.Equal(t, int64(0), res.Miners[3].WalletId)
+	require.Equal(t, int64(0), res.Miners[3].WorkerId)
+	require.Equal(t, res.Miners[0].WorkerId, res.Miners[4].WorkerId)
+
+	// повторный запрос возвращает те же ID
+	res2, err := client.ResolveMiners(ctx, req)
+	require.NoError(t, err)
+	for i := range res.Miners {
+		require.Equal(t, res.Miners[i].CoinId, res2.Miners[i].CoinId)
+		require.Equal(t, res.Miners[i].WalletId, res2.Miners[i].WalletId)
+		require.Equal(t, res.Miners[i].WorkerId, res2.Miners[i].WorkerId)
+	}
+
+	resWallet, err := client.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{
+		Wallet:       "kaswallet",
+		CoinId:       8,
+		RewardMethod: "SOLO",
+	})
+	require.NoError(t, err)
+	require.Equal(t, res.Miners[2].WalletId, resWallet.Id)
+}
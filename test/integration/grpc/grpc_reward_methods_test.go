@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+func TestGRPCRewardMethods(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	// существующим монетам доступны все методы
+	res, err := client.ListRewardMethods(ctx, &proto.ListRewardMethodsRequest{CoinId: 4})
+	require.NoError(t, err)
+	require.Len(t, res.Methods, 6)
+	for _, m := range res.Methods {
+		require.NotEqual(t, proto.RewardMethod_REWARD_METHOD_UNSPECIFIED, m.Method)
+	}
+
+	// несуществующая монета
+	_, err = client.ListRewardMethods(ctx, &proto.ListRewardMethodsRequest{CoinId: 100})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// неизвестный метод
+	_, err = client.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "UNKNOWN"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+func TestGRPCSettingsChange(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	wallet, err := client.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "settings", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+
+	// новый запрос отменяет предыдущий
+	first, err := client.RequestSettingsChange(ctx, &proto.RequestSettingsChangeRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "10"}})
+	require.NoError(t, err)
+	second, err := client.RequestSettingsChange(ctx, &proto.RequestSettingsChangeRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "25.5"}, Actor: "user1"})
+	require.NoError(t, err)
+
+	_, err = client.ConfirmSettingsChange(ctx, &proto.ConfirmSettingsChangeRequest{Token: first.ConfirmationToken})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	confirmed, err := client.ConfirmSettingsChange(ctx, &proto.ConfirmSettingsChangeRequest{Token: second.ConfirmationToken})
+	require.NoError(t, err)
+	require.Equal(t, "25.500000", confirmed.PaymentThreshold.GetValue())
+
+	// токен одноразовый
+	_, err = client.ConfirmSettingsChange(ctx, &proto.ConfirmSettingsChangeRequest{Token: second.ConfirmationToken})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.ConfirmSettingsChange(ctx, &proto.ConfirmSettingsChangeRequest{Token: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	changes, err := client.ListPaymentThresholdChanges(ctx, &proto.ListPaymentThresholdChangesRequest{WalletId: wallet.Id})
+	require.NoError(t, err)
+	require.Len(t, changes.Changes, 1)
+	require.Equal(t, "25.500000", changes.Changes[0].NewValue.GetValue())
+	require.Equal(t, "user1", changes.Changes[0].Actor)
+}
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+func TestGRPCSoftDelete(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	wallet, err := client.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "softdelete", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	ids := make(map[string]int64)
+	for worker, ip := range map[string]string{"rig1": "10.0.0.1", "rgi1": "10.0.0.2", "rig2": "10.0.0.3"} {
+		res, err := client.CreateWorker(ctx, &proto.CreateWorkerRequest{
+			CoinId: 4, Workerfull: "softdelete." + worker, Wallet: "softdelete", Worker: worker, ServerId: "ALPH-1", Ip: ip, RewardMethod: "PPLNS",
+		})
+		require.NoError(t, err)
+		ids[worker] = res.Id
+	}
+	_, err = client.UpdateWorkerStats(ctx, &proto.UpdateWorkerStatsRequest{Stats: []*proto.WorkerStats{
+		{WorkerId: ids["rig1"], IsConnect: true, CurrentHashrate: 100, AverageHashrate: 100},
+		{WorkerId: ids["rgi1"], IsConnect: true, CurrentHashrate: 20, AverageHashrate: 20},
+		{WorkerId: ids["rig2"], IsConnect: true, CurrentHashrate: 50, AverageHashrate: 50},
+	}})
+	require.NoError(t, err)
+
+	// история адресов и хешрейт переносятся в целевой воркер
+	_, err = client.MergeWorkers(ctx, &proto.MergeWorkersRequest{SourceId: ids["rgi1"], TargetId: ids["rig1"]})
+	require.NoError(t, err)
+
+	history, err := client.GetWorkerIPHistory(ctx, &proto.GetWorkerIPHistoryRequest{WorkerId: ids["rig1"]})
+	require.NoError(t, err)
+	require.Len(t, history.History, 2)
+	_, err = client.GetWorkerIPHistory(ctx, &proto.GetWorkerIPHistoryRequest{WorkerId: ids["rgi1"]})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	found, err := client.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{Workerfull: "softdelete.rgi1", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Zero(t, found.Id)
+
+	// удаленный воркер не участвует в сводке по кошельку
+	_, err = client.DeleteWorker(ctx, &proto.DeleteWorkerRequest{Id: ids["rig2"]})
+	require.NoError(t, err)
+	w, err := client.GetWallet(ctx, &proto.GetWalletRequest{Id: wallet.Id})
+	require.NoError(t, err)
+	require.Equal(t, int64(120), w.Wallet.CurrentHashrate)
+
+	// то же имя после удаления - новая запись
+	again, err := client.CreateWorker(ctx, &proto.CreateWorkerRequest{
+		CoinId: 4, Workerfull: "softdelete.rig2", Wallet: "softdelete", Worker: "rig2", ServerId: "ALPH-1", RewardMethod: "PPLNS",
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, ids["rig2"], again.Id)
+
+	// ожидающее изменение настроек отменяется вместе с кошельком
+	pending, err := client.RequestSettingsChange(ctx, &proto.RequestSettingsChangeRequest{WalletId: wallet.Id, PaymentThreshold: &proto.Decimal{Value: "10"}})
+	require.NoError(t, err)
+
+	deleted, err := client.DeleteWallet(ctx, &proto.DeleteWalletRequest{Id: wallet.Id})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), deleted.WorkersDeleted)
+
+	_, err = client.ConfirmSettingsChange(ctx, &proto.ConfirmSettingsChangeRequest{Token: pending.ConfirmationToken})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.GetWallet(ctx, &proto.GetWalletRequest{Id: wallet.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	recreated, err := client.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "softdelete", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.NotEqual(t, wallet.Id, recreated.Id)
+}
@@ -20,6 +20,9 @@ import (
 
 	pb "github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc"
 	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	protov2 "github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto/v2"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/validation"
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/storage/postgres"
 	"github.com/dnsoftware/mpm-miners-processor/internal/constants"
 	tctest "github.com/dnsoftware/mpm-miners-processor/test/testcontainers"
 )
@@ -75,10 +78,17 @@ func setup(t *testing.T) {
 
 	// Поднимаем gRPC-сервер в фоновом процессе
 	go func() {
-		grpcServer := grpc.NewServer()
-		minersServer, err := pb.NewGRPCServer(pool)
+		validator := validation.NewValidator(postgres.NewCoinRepository(pool), pb.LegacyMethods...)
+		grpcServer := grpc.NewServer(
+			grpc.UnaryInterceptor(validator.UnaryServerInterceptor()),
+			grpc.StreamInterceptor(validator.StreamServerInterceptor()),
+		)
+		minersServer, err := pb.NewGRPCServer(postgresDependencies(pool))
 		require.NoError(t, err)
 		proto.RegisterMinersServiceServer(grpcServer, minersServer)
+		minersServerV2, err := pb.NewGRPCServerV2(minersServer)
+		require.NoError(t, err)
+		protov2.RegisterMinersServiceServer(grpcServer, minersServerV2)
 		close(serverReady) // Уведомляем, что сервер готов
 		if err := grpcServer.Serve(lis); err != nil {
 			log.Fatalf("Server exited with error: %v", err)
@@ -88,6 +98,20 @@ func setup(t *testing.T) {
 	<-serverReady // Ждем, пока сервер отправит сигнал готовности (вычитываем пустое значение после закрытия канала)
 }
 
+// postgresDependencies зависимости сервера на Postgres (без приема шар и событий воркеров)
+func postgresDependencies(pool *pgxpool.Pool) pb.Dependencies {
+	return pb.Dependencies{
+		Coins:           postgres.NewCoinRepository(pool),
+		Wallets:         postgres.NewWalletRepository(pool),
+		Workers:         postgres.NewWorkerRepository(pool),
+		Miners:          postgres.NewMinerResolver(pool),
+		RewardMethods:   postgres.NewRewardMethodRepository(pool),
+		WorkerStats:     postgres.NewWorkerStatsUpdater(pool),
+		SettingsChanges: postgres.NewSettingsChangeRepository(pool),
+		Remover:         postgres.NewMinerRemover(pool),
+	}
+}
+
 func TestGRPCServer(t *testing.T) {
 
 	setup(t)
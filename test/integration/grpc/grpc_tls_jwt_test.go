@@ -80,7 +80,7 @@ func TestTLSJWTTest(t *testing.T) {
 
 		interceptor := jwt.GetValidateInterceptor()
 		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(interceptor), grpc.Creds(*serverCreds))
-		minersServer, err := pb.NewGRPCServer(pool)
+		minersServer, err := pb.NewGRPCServer(postgresDependencies(pool))
 		require.NoError(t, err)
 		proto.RegisterMinersServiceServer(grpcServer, minersServer)
 		close(serverReady) // Уведомляем, что сервер готов
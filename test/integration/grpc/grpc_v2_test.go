@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+	protov2 "github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto/v2"
+)
+
+func TestGRPCServerV2(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := protov2.NewMinersServiceClient(conn)
+	clientV1 := proto.NewMinersServiceClient(conn)
+
+	// Coin
+	_, err = client.GetCoinIDByName(ctx, &proto.GetCoinIDByNameRequest{Coin: "NONAME"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Wallet: v2 - NotFound, v1 - по-прежнему id = 0
+	_, err = client.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	resV1, err := clientV1.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), resV1.Id)
+
+	_, err = client.CreateWallet(ctx, &proto.CreateWalletRequest{Name: "wallet", RewardMethod: "PPLNS"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := client.CreateWallet(ctx, &proto.CreateWalletRequest{CoinId: 4, Name: "wallet", RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+
+	res2, err := client.GetWalletIDByName(ctx, &proto.GetWalletIDByNameRequest{Wallet: "wallet", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Equal(t, res.Id, res2.Id)
+
+	// Worker
+	_, err = client.GetWorkerIDByName(ctx, &proto.GetWorkerIDByNameRequest{Workerfull: "wallet.worker", CoinId: 4, RewardMethod: "PPLNS"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
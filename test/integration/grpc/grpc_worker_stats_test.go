@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/dnsoftware/mpm-miners-processor/internal/adapter/grpc/proto"
+)
+
+func TestGRPCUpdateWorkerStats(t *testing.T) {
+
+	setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewMinersServiceClient(conn)
+
+	resolved, err := client.ResolveMiners(ctx, &proto.ResolveMinersRequest{Miners: []*proto.MinerIdentity{
+		{Coin: "ALPH", Wallet: "stats", Workerfull: "stats.rig1", ServerId: "ALPH-1", Ip: "10.0.0.1", RewardMethod: "PPLNS"},
+		{Coin: "ALPH", Wallet: "stats", Workerfull: "stats.rig2", ServerId: "ALPH-1", Ip: "10.0.0.2", RewardMethod: "PPLNS"},
+	}})
+	require.NoError(t, err)
+
+	lastShare := time.Now().Truncate(time.Second).UnixMilli()
+	reported := int64(120)
+	res, err := client.UpdateWorkerStats(ctx, &proto.UpdateWorkerStatsRequest{Stats: []*proto.WorkerStats{
+		{WorkerId: resolved.Miners[0].WorkerId, IsConnect: true, CurrentHashrate: 100, AverageHashrate: 90, LastShareDate: lastShare, CurrentDiff: &proto.Decimal{Value: "1.5"}, ReportedHashrate: &reported},
+		{WorkerId: resolved.Miners[1].WorkerId, IsConnect: false, CurrentHashrate: 0, AverageHashrate: 40},
+		{WorkerId: 999999, IsConnect: true},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), res.Updated)
+
+	workers, err := client.ListWorkersByWallet(ctx, &proto.ListWorkersByWalletRequest{Wallet: "stats", CoinId: 4, RewardMethod: "PPLNS"})
+	require.NoError(t, err)
+	require.Len(t, workers.Workers, 2)
+	require.True(t, workers.Workers[0].IsConnect)
+	require.Equal(t, int64(100), workers.Workers[0].CurrentHashrate)
+	require.Equal(t, lastShare, workers.Workers[0].LastShareDate)
+	require.Equal(t, "1.5000000000", workers.Workers[0].CurrentDiff.GetValue())
+	require.Equal(t, reported, workers.Workers[0].ReportedHashrate)
+	require.NotZero(t, workers.Workers[0].ReportedHashrateDate)
+	require.False(t, workers.Workers[1].IsConnect)
+	require.Zero(t, workers.Workers[1].LastShareDate)
+
+	wallets, err := client.ListWallets(ctx, &proto.ListWalletsRequest{CoinId: 4, NamePrefix: "stats"})
+	require.NoError(t, err)
+	require.Len(t, wallets.Wallets, 1)
+	require.Equal(t, resolved.Miners[0].WalletId, wallets.Wallets[0].Id)
+	require.Equal(t, int64(100), wallets.Wallets[0].CurrentHashrate)
+	require.Equal(t, int64(130), wallets.Wallets[0].AverageHashrate)
+}